@@ -91,8 +91,10 @@ variable "service_labels" {
     "elbv2",
     "emr",
     "emrcontainers",
+    "emrserverless",
     "events",
     "firehose",
+    "fis",
     "fms",
     "forecastservice",
     "frauddetector",
@@ -53,6 +53,28 @@ func ValidateTypeStringNullableBool(v interface{}, k string) (ws []string, es []
 	return
 }
 
+// ValidateTypeStringNullableBoolStrict provides custom error messaging for TypeString booleans
+// that only accept "", "0", "1", "false" and "true", as opposed to the wider set of values
+// accepted by strconv.ParseBool.
+// The message from validation.StringInSlice([]string{"", "false", "true"}, false) is confusing:
+// to be one of [ false true], got 1
+func ValidateTypeStringNullableBoolStrict(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, str := range []string{"", "0", "1", "false", "true"} {
+		if value == str {
+			return
+		}
+	}
+
+	es = append(es, fmt.Errorf("expected %s to be one of [\"\", false, true], got %s", k, value))
+	return
+}
+
 // DiffSuppressNullableBoolFalseAsNull allows false to be treated equivalently to null.
 // This can be used to allow a practitioner to set false when the API requires a null value,
 // as a convenience.
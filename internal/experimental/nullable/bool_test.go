@@ -80,3 +80,43 @@ func TestValidationBool(t *testing.T) {
 		},
 	})
 }
+
+func TestValidationBoolStrict(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "",
+			f:   ValidateTypeStringNullableBoolStrict,
+		},
+		{
+			val: "0",
+			f:   ValidateTypeStringNullableBoolStrict,
+		},
+		{
+			val: "1",
+			f:   ValidateTypeStringNullableBoolStrict,
+		},
+		{
+			val: "true",
+			f:   ValidateTypeStringNullableBoolStrict,
+		},
+		{
+			val: "false",
+			f:   ValidateTypeStringNullableBoolStrict,
+		},
+		{
+			val:         "TRUE",
+			f:           ValidateTypeStringNullableBoolStrict,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be one of \["", false, true\], got TRUE`),
+		},
+		{
+			val:         "invalid",
+			f:           ValidateTypeStringNullableBoolStrict,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be one of \["", false, true\], got invalid`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableBoolStrict,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
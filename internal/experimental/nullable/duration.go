@@ -0,0 +1,148 @@
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableDuration = schema.TypeString
+)
+
+// Duration is a nullable duration, expressed either as an ISO 8601 duration
+// (e.g. "PT1H30M") or a Go duration string (e.g. "1h30m").
+type Duration string
+
+var (
+	// ErrInvalidDuration is returned when a value is neither an ISO 8601 nor a Go duration.
+	ErrInvalidDuration = errors.New("invalid duration")
+
+	iso8601DurationRegexp = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+)
+
+func (d Duration) IsNull() bool {
+	return d == ""
+}
+
+func (d Duration) Value() (time.Duration, bool, error) {
+	if d.IsNull() {
+		return 0, true, nil
+	}
+
+	value, err := parseDuration(string(d))
+	if err != nil {
+		return 0, false, err
+	}
+	return value, false, nil
+}
+
+func NewDuration(v time.Duration) Duration {
+	return Duration(v.String())
+}
+
+// parseDuration parses ISO 8601 durations with week, day, hour, minute and
+// second components, falling back to Go duration syntax.
+// Year and month components are not supported as they do not have a fixed length.
+func parseDuration(s string) (time.Duration, error) {
+	if s == "" || s[0] != 'P' {
+		value, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, err)
+		}
+		return value, nil
+	}
+
+	m := iso8601DurationRegexp.FindStringSubmatch(s)
+	if m == nil || s == "P" || s[len(s)-1] == 'T' {
+		return 0, fmt.Errorf("%w: %q is not a supported ISO 8601 duration", ErrInvalidDuration, s)
+	}
+
+	var value time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute} {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, err)
+		}
+		value += time.Duration(n) * unit
+	}
+
+	if m[5] != "" {
+		seconds, err := strconv.ParseFloat(m[5], 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidDuration, err)
+		}
+		value += time.Duration(seconds * float64(time.Second))
+	}
+
+	return value, nil
+}
+
+// ValidateTypeStringNullableDuration provides custom error messaging for TypeString durations
+// Some arguments require a duration value or an unspecified, empty field.
+func ValidateTypeStringNullableDuration(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := parseDuration(value); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidateTypeStringNullableDurationBetween provides custom error messaging for TypeString durations
+// Some arguments require a duration value or an unspecified, empty field.
+func ValidateTypeStringNullableDurationBetween(min time.Duration, max time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := parseDuration(value)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+			return
+		}
+
+		if v < min || v > max {
+			es = append(es, fmt.Errorf("expected %s to be in the range (%s - %s), got %s", k, min, max, v))
+		}
+
+		return
+	}
+}
+
+// DiffSuppressNullableDurationEquivalent suppresses differences between
+// representations of the same duration, e.g. "PT1H", "1h" and "60m".
+func DiffSuppressNullableDurationEquivalent(k, o, n string, d *schema.ResourceData) bool {
+	ov, onull, oerr := Duration(o).Value()
+	nv, nnull, nerr := Duration(n).Value()
+	if oerr != nil || nerr != nil {
+		return false
+	}
+	if onull || nnull {
+		return onull == nnull
+	}
+	return ov == nv
+}
@@ -0,0 +1,154 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNullableDuration(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue time.Duration
+		expectedErr   error
+	}{
+		{
+			val:           "1h30m",
+			expectNull:    false,
+			expectedValue: 90 * time.Minute,
+		},
+		{
+			val:           "PT1H30M",
+			expectNull:    false,
+			expectedValue: 90 * time.Minute,
+		},
+		{
+			val:           "P1W2DT3H4M5.5S",
+			expectNull:    false,
+			expectedValue: 9*24*time.Hour + 3*time.Hour + 4*time.Minute + 5500*time.Millisecond,
+		},
+		{
+			val:           "PT0S",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:         "P",
+			expectedErr: ErrInvalidDuration,
+		},
+		{
+			val:         "P1DT",
+			expectedErr: ErrInvalidDuration,
+		},
+		{
+			val:         "P1Y",
+			expectedErr: ErrInvalidDuration,
+		},
+		{
+			val:         "A",
+			expectedErr: ErrInvalidDuration,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Duration(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %s, got %s", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val: "PT5M",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val: "5m",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val:         "P1M",
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'P1M' as duration: .*`),
+		},
+		{
+			val:         5,
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "PT1M",
+			f:   ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+		},
+		{
+			val: "1h",
+			f:   ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+		},
+		{
+			val:         "PT59S",
+			f:           ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be in the range \(1m0s - 1h0m0s\), got 59s`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableDurationEquivalent(t *testing.T) {
+	cases := []struct {
+		old, new string
+		equal    bool
+	}{
+		{old: "PT1H", new: "1h", equal: true},
+		{old: "60m", new: "PT3600S", equal: true},
+		{old: "P1D", new: "24h", equal: true},
+		{old: "PT1H", new: "PT2H", equal: false},
+		{old: "", new: "", equal: true},
+		{old: "PT0S", new: "", equal: false},
+		{old: "A", new: "A", equal: false},
+	}
+
+	for i, tc := range cases {
+		if got := DiffSuppressNullableDurationEquivalent("test_property", tc.old, tc.new, nil); got != tc.equal {
+			t.Fatalf("expected test case %d to return %t, got %t", i, tc.equal, got)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestNullableFloat(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue float64
+		expectedErr   error
+	}{
+		{
+			val:           "1",
+			expectNull:    false,
+			expectedValue: 1,
+		},
+		{
+			val:           "1.5",
+			expectNull:    false,
+			expectedValue: 1.5,
+		},
+		{
+			val:           "0",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectedErr:   strconv.ErrSyntax,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Float(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %f, got %f", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestNewFloat(t *testing.T) {
+	if v := NewFloat(42.5); v != "42.5" {
+		t.Fatalf("expected 42.5, got %s", v)
+	}
+	if v := NewFloat(3); v != "3" {
+		t.Fatalf("expected 3, got %s", v)
+	}
+}
+
+func TestValidationFloat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val: "42.0",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val:         "threeve",
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'threeve' as float: .*`),
+		},
+		{
+			val:         1.0,
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloatAtLeast(1.5),
+		},
+		{
+			val:         "1.4",
+			f:           ValidateTypeStringNullableFloatAtLeast(1.5),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(1\.500000\), got 1\.400000`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloatAtLeast(1.5),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0.5",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val:         "1.1",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be in the range \(0\.000000 - 1\.000000\), got 1\.100000`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableFloatEquivalent(t *testing.T) {
+	cases := []struct {
+		old, new string
+		equal    bool
+	}{
+		{old: "1", new: "1.0", equal: true},
+		{old: "1.50", new: "1.5", equal: true},
+		{old: "1", new: "2", equal: false},
+		{old: "", new: "", equal: true},
+		{old: "0", new: "", equal: false},
+		{old: "", new: "1", equal: false},
+		{old: "A", new: "A", equal: false},
+	}
+
+	for i, tc := range cases {
+		if got := DiffSuppressNullableFloatEquivalent("test_property", tc.old, tc.new, nil); got != tc.equal {
+			t.Fatalf("expected test case %d to return %t, got %t", i, tc.equal, got)
+		}
+	}
+}
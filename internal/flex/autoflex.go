@@ -0,0 +1,709 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Expand populates the API object pointed to by apiObject from a Terraform block,
+// which may be a map[string]interface{} or a single element []interface{}.
+// rawConfig is the block's value in the raw configuration, e.g. d.GetRawConfig().GetAttr("block").
+// Attributes are matched to the exported field names of the API object by
+// converting the field name to snake_case (see FieldNameToAttributeName).
+//
+// The following field types are supported:
+//   - *string, *bool, *int64 and *float64 pointer scalars
+//   - *time.Time, represented in Terraform as an RFC 3339 string
+//   - enums, which in the AWS SDK are *string fields
+//   - []*string, []*int64 and map[string]*string
+//   - *struct, represented in Terraform as a single element list (MaxItems: 1 block)
+//   - []*struct, represented in Terraform as a list or set of blocks
+//
+// Attributes that are null in rawConfig, i.e. not set in configuration, leave
+// the field nil, while zero values that are set in configuration, e.g. false
+// and 0, are expanded. If rawConfig is unknown, e.g. cty.DynamicVal, all
+// attributes present in the block are treated as set. Empty strings, empty
+// lists, sets and maps always leave the field nil.
+//
+// Fields with no matching attribute are left untouched, as are blob ([]byte),
+// interface (e.g. io.ReadSeeker) and aws.JSONValue fields, which have no
+// generic Terraform representation.
+func Expand(tfBlock interface{}, rawConfig cty.Value, apiObject interface{}) error {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a non-nil pointer to struct, got %T", apiObject)
+	}
+
+	tfMap, ok := blockToMap(tfBlock)
+
+	if !ok {
+		return nil
+	}
+
+	return expandStruct(tfMap, configBlock(rawConfig), v.Elem(), "")
+}
+
+// Flatten returns a Terraform block (as a map[string]interface{}) for the
+// specified API object, which must be a struct or pointer to struct.
+// Unset (nil) fields are omitted and a nil API object returns a nil map.
+// Blob ([]byte), interface and aws.JSONValue fields are also omitted.
+func Flatten(apiObject interface{}) (map[string]interface{}, error) {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected struct or pointer to struct, got %T", apiObject)
+	}
+
+	return flattenStruct(v, nil, "")
+}
+
+// FlattenWithSchema is Flatten, but omits fields with no corresponding attribute
+// in the specified schema, as required by d.Set. Nested blocks are flattened
+// using their nested schema.
+func FlattenWithSchema(apiObject interface{}, s map[string]*schema.Schema) (map[string]interface{}, error) {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected struct or pointer to struct, got %T", apiObject)
+	}
+
+	if s == nil {
+		s = map[string]*schema.Schema{}
+	}
+
+	return flattenStruct(v, s, "")
+}
+
+// FlattenList returns a Terraform list of blocks for the specified API object.
+// A nil API object returns an empty list, matching the convention of nested
+// flatten functions for MaxItems: 1 blocks.
+func FlattenList(apiObject interface{}) ([]interface{}, error) {
+	tfMap, err := Flatten(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tfMap == nil {
+		return []interface{}{}, nil
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+// FieldNameToAttributeName converts an AWS SDK struct field name to its
+// snake_case Terraform attribute name, e.g. "DBInstanceIdentifier" => "db_instance_identifier".
+func FieldNameToAttributeName(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+					sb.WriteRune('_')
+				}
+			}
+
+			sb.WriteRune(unicode.ToLower(r))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func blockToMap(tfBlock interface{}) (map[string]interface{}, bool) {
+	switch v := tfBlock.(type) {
+	case map[string]interface{}:
+		return v, v != nil
+	case []interface{}:
+		if len(v) == 0 || v[0] == nil {
+			return nil, false
+		}
+
+		tfMap, ok := v[0].(map[string]interface{})
+
+		return tfMap, ok
+	case *schema.Set:
+		if v == nil {
+			return nil, false
+		}
+
+		return blockToMap(v.List())
+	default:
+		return nil, false
+	}
+}
+
+func listValue(v interface{}) ([]interface{}, bool) {
+	switch v := v.(type) {
+	case []interface{}:
+		return v, true
+	case *schema.Set:
+		if v == nil {
+			return nil, false
+		}
+
+		return v.List(), true
+	default:
+		return nil, false
+	}
+}
+
+// configAttr returns the named attribute of the specified configuration object.
+// A null value is returned if the object is null, and an unknown value if the
+// object is unknown or has no such attribute.
+func configAttr(config cty.Value, name string) cty.Value {
+	if !config.IsKnown() {
+		return cty.DynamicVal
+	}
+
+	if config.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	if !config.Type().IsObjectType() || !config.Type().HasAttribute(name) {
+		return cty.DynamicVal
+	}
+
+	return config.GetAttr(name)
+}
+
+// configBlock returns the configuration object of a single nested block,
+// which is represented as a list or set of one element.
+func configBlock(config cty.Value) cty.Value {
+	if !config.IsKnown() || config.IsNull() {
+		return config
+	}
+
+	if t := config.Type(); !t.IsListType() && !t.IsSetType() && !t.IsTupleType() {
+		return config
+	}
+
+	if config.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	it := config.ElementIterator()
+	it.Next()
+	_, v := it.Element()
+
+	return v
+}
+
+// configBlocks returns the configuration object of each of the specified
+// nested blocks. List elements are matched by index. As set elements are not
+// ordered, they are matched by their primitive attribute values.
+// An unknown value is returned for blocks that cannot be matched.
+func configBlocks(config cty.Value, tfList []interface{}) []cty.Value {
+	configs := make([]cty.Value, len(tfList))
+	for i := range configs {
+		configs[i] = cty.DynamicVal
+	}
+
+	if !config.IsKnown() || config.IsNull() || !config.CanIterateElements() {
+		return configs
+	}
+
+	var elems []cty.Value
+	for it := config.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		elems = append(elems, v)
+	}
+
+	if !config.Type().IsSetType() {
+		if len(elems) == len(tfList) {
+			copy(configs, elems)
+		}
+
+		return configs
+	}
+
+	matched := make([]bool, len(elems))
+
+	for i, tfElem := range tfList {
+		tfMap, ok := tfElem.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for j, elem := range elems {
+			if !matched[j] && configMatches(elem, tfMap) {
+				configs[i] = elem
+				matched[j] = true
+
+				break
+			}
+		}
+	}
+
+	return configs
+}
+
+// configMatches returns whether or not the known, non-null primitive attributes
+// of the specified configuration object equal the corresponding Terraform values.
+func configMatches(config cty.Value, tfMap map[string]interface{}) bool {
+	if !config.IsKnown() || config.IsNull() || !config.Type().IsObjectType() {
+		return false
+	}
+
+	for name, t := range config.Type().AttributeTypes() {
+		v := config.GetAttr(name)
+
+		if !t.IsPrimitiveType() || !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		switch t {
+		case cty.String:
+			if s, ok := tfMap[name].(string); !ok || s != v.AsString() {
+				return false
+			}
+		case cty.Bool:
+			if b, ok := tfMap[name].(bool); !ok || b != v.True() {
+				return false
+			}
+		case cty.Number:
+			f, _ := v.AsBigFloat().Float64()
+
+			switch n := tfMap[name].(type) {
+			case int:
+				if float64(n) != f {
+					return false
+				}
+			case float64:
+				if n != f {
+					return false
+				}
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// skipFieldType returns whether or not fields of the specified type are
+// ignored by Expand and Flatten.
+func skipFieldType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	case reflect.Map:
+		return t.Elem().Kind() == reflect.Interface
+	default:
+		return false
+	}
+}
+
+func attributePath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
+
+func expandStruct(tfMap map[string]interface{}, config cty.Value, v reflect.Value, path string) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" {
+			// Unexported, including the AWS SDK's "_ struct{}" metadata field.
+			continue
+		}
+
+		if skipFieldType(field.Type) {
+			continue
+		}
+
+		name := FieldNameToAttributeName(field.Name)
+		tfValue, ok := tfMap[name]
+
+		if !ok || tfValue == nil {
+			continue
+		}
+
+		// Attributes not set in configuration, e.g. omitted Optional attributes
+		// and Computed values read into state, are not expanded.
+		attrConfig := configAttr(config, name)
+
+		if attrConfig.IsKnown() && attrConfig.IsNull() {
+			continue
+		}
+
+		if err := expandValue(tfValue, attrConfig, v.Field(i), attributePath(path, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandValue(tfValue interface{}, config cty.Value, v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		return expandPointer(tfValue, config, v, path)
+	case reflect.Slice:
+		return expandSlice(tfValue, config, v, path)
+	case reflect.Map:
+		return expandMap(tfValue, v, path)
+	default:
+		return fmt.Errorf("%s: unsupported field type %s", path, v.Type())
+	}
+}
+
+func expandPointer(tfValue interface{}, config cty.Value, v reflect.Value, path string) error {
+	elemType := v.Type().Elem()
+
+	switch elemType.Kind() {
+	case reflect.String:
+		s, ok := tfValue.(string)
+
+		if !ok {
+			return fmt.Errorf("%s: expected string, got %T", path, tfValue)
+		}
+
+		if s == "" {
+			return nil
+		}
+
+		v.Set(reflect.ValueOf(&s).Convert(v.Type()))
+	case reflect.Bool:
+		b, ok := tfValue.(bool)
+
+		if !ok {
+			return fmt.Errorf("%s: expected bool, got %T", path, tfValue)
+		}
+
+		v.Set(reflect.ValueOf(&b).Convert(v.Type()))
+	case reflect.Int64:
+		n, ok := tfValue.(int)
+
+		if !ok {
+			return fmt.Errorf("%s: expected int, got %T", path, tfValue)
+		}
+
+		i := int64(n)
+		v.Set(reflect.ValueOf(&i).Convert(v.Type()))
+	case reflect.Float64:
+		f, ok := tfValue.(float64)
+
+		if !ok {
+			return fmt.Errorf("%s: expected float64, got %T", path, tfValue)
+		}
+
+		v.Set(reflect.ValueOf(&f).Convert(v.Type()))
+	case reflect.Struct:
+		if elemType == timeType {
+			s, ok := tfValue.(string)
+
+			if !ok {
+				return fmt.Errorf("%s: expected string, got %T", path, tfValue)
+			}
+
+			if s == "" {
+				return nil
+			}
+
+			t, err := time.Parse(time.RFC3339, s)
+
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			v.Set(reflect.ValueOf(&t))
+
+			return nil
+		}
+
+		tfMap, ok := blockToMap(tfValue)
+
+		if !ok {
+			return nil
+		}
+
+		elem := reflect.New(elemType)
+
+		if err := expandStruct(tfMap, configBlock(config), elem.Elem(), path); err != nil {
+			return err
+		}
+
+		v.Set(elem)
+	default:
+		return fmt.Errorf("%s: unsupported field type %s", path, v.Type())
+	}
+
+	return nil
+}
+
+func expandSlice(tfValue interface{}, config cty.Value, v reflect.Value, path string) error {
+	tfList, ok := listValue(tfValue)
+
+	if !ok {
+		return fmt.Errorf("%s: expected list or set, got %T", path, tfValue)
+	}
+
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	elemType := v.Type().Elem()
+	slice := reflect.MakeSlice(v.Type(), 0, len(tfList))
+	configs := configBlocks(config, tfList)
+
+	for i, tfElem := range tfList {
+		if tfElem == nil {
+			continue
+		}
+
+		elemPath := fmt.Sprintf("%s.%d", path, i)
+
+		if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct && elemType.Elem() != timeType {
+			tfMap, ok := tfElem.(map[string]interface{})
+
+			if !ok {
+				return fmt.Errorf("%s: expected block, got %T", elemPath, tfElem)
+			}
+
+			elem := reflect.New(elemType.Elem())
+
+			if err := expandStruct(tfMap, configs[i], elem.Elem(), elemPath); err != nil {
+				return err
+			}
+
+			slice = reflect.Append(slice, elem)
+
+			continue
+		}
+
+		elem := reflect.New(elemType).Elem()
+
+		if err := expandValue(tfElem, cty.DynamicVal, elem, elemPath); err != nil {
+			return err
+		}
+
+		// Mirror ExpandStringList and drop empty elements.
+		if elem.Kind() == reflect.Ptr && elem.IsNil() {
+			continue
+		}
+
+		slice = reflect.Append(slice, elem)
+	}
+
+	v.Set(slice)
+
+	return nil
+}
+
+func expandMap(tfValue interface{}, v reflect.Value, path string) error {
+	tfMap, ok := tfValue.(map[string]interface{})
+
+	if !ok {
+		return fmt.Errorf("%s: expected map, got %T", path, tfValue)
+	}
+
+	if v.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("%s: unsupported field type %s", path, v.Type())
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	m := reflect.MakeMapWithSize(v.Type(), len(tfMap))
+
+	for k, tfElem := range tfMap {
+		elem := reflect.New(v.Type().Elem()).Elem()
+
+		if err := expandValue(tfElem, cty.DynamicVal, elem, attributePath(path, k)); err != nil {
+			return err
+		}
+
+		m.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), elem)
+	}
+
+	v.Set(m)
+
+	return nil
+}
+
+// flattenStruct flattens the specified struct value. If s is not nil, fields
+// with no corresponding attribute in the schema are omitted.
+func flattenStruct(v reflect.Value, s map[string]*schema.Schema, path string) (map[string]interface{}, error) {
+	t := v.Type()
+	tfMap := map[string]interface{}{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" || skipFieldType(field.Type) {
+			continue
+		}
+
+		name := FieldNameToAttributeName(field.Name)
+
+		var elemSchema map[string]*schema.Schema
+
+		if s != nil {
+			attr, ok := s[name]
+
+			if !ok {
+				continue
+			}
+
+			elemSchema = map[string]*schema.Schema{}
+
+			if r, ok := attr.Elem.(*schema.Resource); ok {
+				elemSchema = r.Schema
+			}
+		}
+
+		tfValue, ok, err := flattenValue(v.Field(i), elemSchema, attributePath(path, name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			tfMap[name] = tfValue
+		}
+	}
+
+	return tfMap, nil
+}
+
+// flattenValue returns the Terraform value for the specified field value and
+// whether or not the field was set.
+func flattenValue(v reflect.Value, s map[string]*schema.Schema, path string) (interface{}, bool, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+
+		elem := v.Elem()
+
+		switch elem.Kind() {
+		case reflect.String:
+			return elem.String(), true, nil
+		case reflect.Bool:
+			return elem.Bool(), true, nil
+		case reflect.Int64:
+			return int(elem.Int()), true, nil
+		case reflect.Float64:
+			return elem.Float(), true, nil
+		case reflect.Struct:
+			if elem.Type() == timeType {
+				return elem.Interface().(time.Time).Format(time.RFC3339), true, nil
+			}
+
+			tfMap, err := flattenStruct(elem, s, path)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []interface{}{tfMap}, true, nil
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+
+		tfList := make([]interface{}, 0, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			elemPath := fmt.Sprintf("%s.%d", path, i)
+
+			if elem.Kind() == reflect.Ptr && !elem.IsNil() && elem.Elem().Kind() == reflect.Struct && elem.Type().Elem() != timeType {
+				tfMap, err := flattenStruct(elem.Elem(), s, elemPath)
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				tfList = append(tfList, tfMap)
+
+				continue
+			}
+
+			tfElem, ok, err := flattenValue(elem, nil, elemPath)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfList = append(tfList, tfElem)
+			}
+		}
+
+		return tfList, true, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		tfMap := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			k := iter.Key().String()
+			tfElem, ok, err := flattenValue(iter.Value(), nil, attributePath(path, k))
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfMap[k] = tfElem
+			}
+		}
+
+		return tfMap, true, nil
+	}
+
+	return nil, false, fmt.Errorf("%s: unsupported field type %s", path, v.Type())
+}
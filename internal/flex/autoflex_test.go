@@ -0,0 +1,835 @@
+package flex
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type autoFlexTestNested struct {
+	_ struct{} `type:"structure"`
+
+	Key   *string `type:"string"`
+	Value *string `type:"string"`
+}
+
+type autoFlexTestStruct struct {
+	_ struct{} `type:"structure"`
+
+	BucketName           *string                        `type:"string"`
+	CreatedAt            *time.Time                     `type:"timestamp"`
+	DBInstanceIdentifier *string                        `type:"string"`
+	Enabled              *bool                          `type:"boolean"`
+	Ipv6CidrBlock        *string                        `type:"string"`
+	KMSKeyId             *string                        `type:"string"`
+	MaxSize              *int64                         `type:"integer"`
+	Nested               *autoFlexTestNested            `type:"structure"`
+	Ports                []*int64                       `type:"list"`
+	Ratio                *float64                       `type:"double"`
+	Status               *string                        `type:"string" enum:"Status"`
+	SubnetIds            []*string                      `type:"list"`
+	Tags                 map[string]*string             `type:"map"`
+	Items                []*autoFlexTestNested          `type:"list"`
+	NestedMap            map[string]*autoFlexTestNested `type:"map"`
+
+	unexported *string
+}
+
+func TestFieldNameToAttributeName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "Name", Expected: "name"},
+		{Input: "BucketName", Expected: "bucket_name"},
+		{Input: "VpcId", Expected: "vpc_id"},
+		{Input: "DBInstanceIdentifier", Expected: "db_instance_identifier"},
+		{Input: "KMSKeyId", Expected: "kms_key_id"},
+		{Input: "Ipv6CidrBlock", Expected: "ipv6_cidr_block"},
+		{Input: "ARN", Expected: "arn"},
+		{Input: "S3Bucket", Expected: "s3_bucket"},
+		{Input: "EnableDnsHostnames", Expected: "enable_dns_hostnames"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Input, func(t *testing.T) {
+			got := FieldNameToAttributeName(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	createdAt := time.Date(2021, 11, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		Input         interface{}
+		Expected      *autoFlexTestStruct
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: &autoFlexTestStruct{},
+		},
+		{
+			Name:     "empty list",
+			Input:    []interface{}{},
+			Expected: &autoFlexTestStruct{},
+		},
+		{
+			Name:     "list containing nil",
+			Input:    []interface{}{nil},
+			Expected: &autoFlexTestStruct{},
+		},
+		{
+			Name: "scalars",
+			Input: map[string]interface{}{
+				"bucket_name":            "test",
+				"db_instance_identifier": "db-1",
+				"enabled":                true,
+				"ipv6_cidr_block":        "2001:db8::/56",
+				"kms_key_id":             "alias/test",
+				"max_size":               3,
+				"ratio":                  0.5,
+				"status":                 "ACTIVE",
+			},
+			Expected: &autoFlexTestStruct{
+				BucketName:           aws.String("test"),
+				DBInstanceIdentifier: aws.String("db-1"),
+				Enabled:              aws.Bool(true),
+				Ipv6CidrBlock:        aws.String("2001:db8::/56"),
+				KMSKeyId:             aws.String("alias/test"),
+				MaxSize:              aws.Int64(3),
+				Ratio:                aws.Float64(0.5),
+				Status:               aws.String("ACTIVE"),
+			},
+		},
+		{
+			Name: "zero values",
+			Input: map[string]interface{}{
+				"bucket_name": "",
+				"enabled":     false,
+				"max_size":    0,
+				"ratio":       0.0,
+			},
+			Expected: &autoFlexTestStruct{
+				Enabled: aws.Bool(false),
+				MaxSize: aws.Int64(0),
+				Ratio:   aws.Float64(0),
+			},
+		},
+		{
+			Name: "zero list elements",
+			Input: map[string]interface{}{
+				"ports": []interface{}{0, 443},
+			},
+			Expected: &autoFlexTestStruct{
+				Ports: aws.Int64Slice([]int64{0, 443}),
+			},
+		},
+		{
+			Name: "single element list",
+			Input: []interface{}{
+				map[string]interface{}{
+					"bucket_name": "test",
+				},
+			},
+			Expected: &autoFlexTestStruct{
+				BucketName: aws.String("test"),
+			},
+		},
+		{
+			Name: "unknown attributes are ignored",
+			Input: map[string]interface{}{
+				"bucket_name": "test",
+				"unknown":     "value",
+				"unexported":  "value",
+			},
+			Expected: &autoFlexTestStruct{
+				BucketName: aws.String("test"),
+			},
+		},
+		{
+			Name: "time",
+			Input: map[string]interface{}{
+				"created_at": "2021-11-01T12:30:00Z",
+			},
+			Expected: &autoFlexTestStruct{
+				CreatedAt: aws.Time(createdAt),
+			},
+		},
+		{
+			Name: "empty time",
+			Input: map[string]interface{}{
+				"created_at": "",
+			},
+			Expected: &autoFlexTestStruct{},
+		},
+		{
+			Name: "invalid time",
+			Input: map[string]interface{}{
+				"created_at": "yesterday",
+			},
+			ExpectedError: regexp.MustCompile(`created_at: parsing time`),
+		},
+		{
+			Name: "lists",
+			Input: map[string]interface{}{
+				"ports":      []interface{}{80, 443},
+				"subnet_ids": []interface{}{"subnet-1", "", "subnet-2"},
+			},
+			Expected: &autoFlexTestStruct{
+				Ports:     aws.Int64Slice([]int64{80, 443}),
+				SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+			},
+		},
+		{
+			Name: "empty list attribute",
+			Input: map[string]interface{}{
+				"subnet_ids": []interface{}{},
+			},
+			Expected: &autoFlexTestStruct{},
+		},
+		{
+			Name: "set",
+			Input: map[string]interface{}{
+				"subnet_ids": schema.NewSet(schema.HashString, []interface{}{"subnet-1"}),
+			},
+			Expected: &autoFlexTestStruct{
+				SubnetIds: aws.StringSlice([]string{"subnet-1"}),
+			},
+		},
+		{
+			Name: "map",
+			Input: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Name": "test",
+				},
+			},
+			Expected: &autoFlexTestStruct{
+				Tags: aws.StringMap(map[string]string{"Name": "test"}),
+			},
+		},
+		{
+			Name: "nested block",
+			Input: map[string]interface{}{
+				"nested": []interface{}{
+					map[string]interface{}{
+						"key":   "k",
+						"value": "v",
+					},
+				},
+			},
+			Expected: &autoFlexTestStruct{
+				Nested: &autoFlexTestNested{
+					Key:   aws.String("k"),
+					Value: aws.String("v"),
+				},
+			},
+		},
+		{
+			Name: "empty nested block",
+			Input: map[string]interface{}{
+				"nested": []interface{}{},
+			},
+			Expected: &autoFlexTestStruct{},
+		},
+		{
+			Name: "repeated nested blocks",
+			Input: map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{
+						"key": "k1",
+					},
+					map[string]interface{}{
+						"key":   "k2",
+						"value": "v2",
+					},
+				},
+			},
+			Expected: &autoFlexTestStruct{
+				Items: []*autoFlexTestNested{
+					{
+						Key: aws.String("k1"),
+					},
+					{
+						Key:   aws.String("k2"),
+						Value: aws.String("v2"),
+					},
+				},
+			},
+		},
+		{
+			Name: "map of nested blocks",
+			Input: map[string]interface{}{
+				"nested_map": map[string]interface{}{
+					"a": []interface{}{
+						map[string]interface{}{
+							"key": "k",
+						},
+					},
+				},
+			},
+			Expected: &autoFlexTestStruct{
+				NestedMap: map[string]*autoFlexTestNested{
+					"a": {
+						Key: aws.String("k"),
+					},
+				},
+			},
+		},
+		{
+			Name: "type mismatch",
+			Input: map[string]interface{}{
+				"max_size": "3",
+			},
+			ExpectedError: regexp.MustCompile(`max_size: expected int, got string`),
+		},
+		{
+			Name: "nested type mismatch",
+			Input: map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{
+						"key": 1,
+					},
+				},
+			},
+			ExpectedError: regexp.MustCompile(`items\.0\.key: expected string, got int`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := &autoFlexTestStruct{}
+			err := Expand(testCase.Input, cty.DynamicVal, got)
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.ExpectedError)
+				}
+
+				if !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Fatalf(
+					"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+					got,
+					testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandRawConfig(t *testing.T) {
+	nestedType := cty.Object(map[string]cty.Type{
+		"key":   cty.String,
+		"value": cty.String,
+	})
+	configType := cty.Object(map[string]cty.Type{
+		"enabled":  cty.Bool,
+		"max_size": cty.Number,
+		"items":    cty.Set(nestedType),
+		"nested":   cty.List(nestedType),
+	})
+
+	testCases := []struct {
+		Name      string
+		Input     interface{}
+		RawConfig cty.Value
+		Expected  *autoFlexTestStruct
+	}{
+		{
+			Name: "explicit false",
+			Input: map[string]interface{}{
+				"enabled":  false,
+				"max_size": 0,
+			},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"enabled":  cty.False,
+				"max_size": cty.Zero,
+				"items":    cty.NullVal(cty.Set(nestedType)),
+				"nested":   cty.NullVal(cty.List(nestedType)),
+			}),
+			Expected: &autoFlexTestStruct{
+				Enabled: aws.Bool(false),
+				MaxSize: aws.Int64(0),
+			},
+		},
+		{
+			Name: "unset",
+			Input: map[string]interface{}{
+				"enabled":  false,
+				"max_size": 0,
+			},
+			RawConfig: cty.NullVal(configType),
+			Expected:  &autoFlexTestStruct{},
+		},
+		{
+			Name: "unset attributes",
+			Input: map[string]interface{}{
+				"enabled":  false,
+				"max_size": 3,
+			},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"enabled":  cty.NullVal(cty.Bool),
+				"max_size": cty.NumberIntVal(3),
+				"items":    cty.NullVal(cty.Set(nestedType)),
+				"nested":   cty.NullVal(cty.List(nestedType)),
+			}),
+			Expected: &autoFlexTestStruct{
+				MaxSize: aws.Int64(3),
+			},
+		},
+		{
+			Name: "single element list",
+			Input: []interface{}{
+				map[string]interface{}{
+					"enabled": false,
+				},
+			},
+			RawConfig: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"enabled":  cty.False,
+					"max_size": cty.NullVal(cty.Number),
+					"items":    cty.NullVal(cty.Set(nestedType)),
+					"nested":   cty.NullVal(cty.List(nestedType)),
+				}),
+			}),
+			Expected: &autoFlexTestStruct{
+				Enabled: aws.Bool(false),
+			},
+		},
+		{
+			Name: "nested blocks",
+			Input: map[string]interface{}{
+				"nested": []interface{}{
+					map[string]interface{}{
+						"key":   "k",
+						"value": "",
+					},
+				},
+				// Set elements are not in configuration order.
+				"items": []interface{}{
+					map[string]interface{}{"key": "b", "value": "2"},
+					map[string]interface{}{"key": "a", "value": "1"},
+				},
+			},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"enabled":  cty.NullVal(cty.Bool),
+				"max_size": cty.NullVal(cty.Number),
+				"items": cty.SetVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"key":   cty.StringVal("a"),
+						"value": cty.StringVal("1"),
+					}),
+					cty.ObjectVal(map[string]cty.Value{
+						"key":   cty.StringVal("b"),
+						"value": cty.NullVal(cty.String),
+					}),
+				}),
+				"nested": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"key":   cty.StringVal("k"),
+						"value": cty.NullVal(cty.String),
+					}),
+				}),
+			}),
+			Expected: &autoFlexTestStruct{
+				Items: []*autoFlexTestNested{
+					{Key: aws.String("b")},
+					{Key: aws.String("a"), Value: aws.String("1")},
+				},
+				Nested: &autoFlexTestNested{
+					Key: aws.String("k"),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := &autoFlexTestStruct{}
+
+			if err := Expand(testCase.Input, testCase.RawConfig, got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Fatalf(
+					"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+					got,
+					testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandInvalidTarget(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Target interface{}
+	}{
+		{
+			Name:   "nil",
+			Target: nil,
+		},
+		{
+			Name:   "struct value",
+			Target: autoFlexTestStruct{},
+		},
+		{
+			Name:   "nil pointer",
+			Target: (*autoFlexTestStruct)(nil),
+		},
+		{
+			Name:   "pointer to string",
+			Target: aws.String("test"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if err := Expand(map[string]interface{}{}, cty.DynamicVal, testCase.Target); err == nil {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestExpandUnsupportedFieldType(t *testing.T) {
+	type unsupported struct {
+		Count int
+	}
+
+	err := Expand(map[string]interface{}{"count": 1}, cty.DynamicVal, &unsupported{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if expected := regexp.MustCompile(`count: unsupported field type int`); !expected.MatchString(err.Error()) {
+		t.Fatalf("expected error matching %q, got %s", expected, err)
+	}
+}
+
+type autoFlexTestSkipped struct {
+	_ struct{} `type:"structure"`
+
+	Blob     []byte        `type:"blob"`
+	Body     io.ReadSeeker `type:"blob"`
+	Document aws.JSONValue `type:"jsonvalue"`
+	Name     *string       `type:"string"`
+}
+
+func TestExpandSkippedFieldTypes(t *testing.T) {
+	tfMap := map[string]interface{}{
+		"blob":     "data",
+		"body":     "data",
+		"document": map[string]interface{}{"key": "value"},
+		"name":     "test",
+	}
+
+	got := &autoFlexTestSkipped{}
+
+	if err := Expand(tfMap, cty.DynamicVal, got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := (&autoFlexTestSkipped{Name: aws.String("test")}); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestFlattenSkippedFieldTypes(t *testing.T) {
+	apiObject := &autoFlexTestSkipped{
+		Blob:     []byte("data"),
+		Body:     bytes.NewReader([]byte("data")),
+		Document: aws.JSONValue{"key": "value"},
+		Name:     aws.String("test"),
+	}
+
+	got, err := Flatten(apiObject)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := map[string]interface{}{"name": "test"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	createdAt := time.Date(2021, 11, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Input    interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    (*autoFlexTestStruct)(nil),
+			Expected: nil,
+		},
+		{
+			Name:     "empty",
+			Input:    &autoFlexTestStruct{},
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name: "struct value",
+			Input: autoFlexTestStruct{
+				BucketName: aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"bucket_name": "test",
+			},
+		},
+		{
+			Name: "scalars",
+			Input: &autoFlexTestStruct{
+				BucketName:           aws.String("test"),
+				CreatedAt:            aws.Time(createdAt),
+				DBInstanceIdentifier: aws.String("db-1"),
+				Enabled:              aws.Bool(false),
+				KMSKeyId:             aws.String("alias/test"),
+				MaxSize:              aws.Int64(3),
+				Ratio:                aws.Float64(0.5),
+				Status:               aws.String("ACTIVE"),
+				unexported:           aws.String("ignored"),
+			},
+			Expected: map[string]interface{}{
+				"bucket_name":            "test",
+				"created_at":             "2021-11-01T12:30:00Z",
+				"db_instance_identifier": "db-1",
+				"enabled":                false,
+				"kms_key_id":             "alias/test",
+				"max_size":               3,
+				"ratio":                  0.5,
+				"status":                 "ACTIVE",
+			},
+		},
+		{
+			Name: "lists and maps",
+			Input: &autoFlexTestStruct{
+				Ports:     aws.Int64Slice([]int64{80, 443}),
+				SubnetIds: []*string{},
+				Tags:      aws.StringMap(map[string]string{"Name": "test"}),
+			},
+			Expected: map[string]interface{}{
+				"ports":      []interface{}{80, 443},
+				"subnet_ids": []interface{}{},
+				"tags": map[string]interface{}{
+					"Name": "test",
+				},
+			},
+		},
+		{
+			Name: "nested blocks",
+			Input: &autoFlexTestStruct{
+				Nested: &autoFlexTestNested{
+					Key: aws.String("k"),
+				},
+				Items: []*autoFlexTestNested{
+					{
+						Key:   aws.String("k1"),
+						Value: aws.String("v1"),
+					},
+					nil,
+				},
+				NestedMap: map[string]*autoFlexTestNested{
+					"a": {
+						Value: aws.String("v"),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"nested": []interface{}{
+					map[string]interface{}{
+						"key": "k",
+					},
+				},
+				"items": []interface{}{
+					map[string]interface{}{
+						"key":   "k1",
+						"value": "v1",
+					},
+				},
+				"nested_map": map[string]interface{}{
+					"a": []interface{}{
+						map[string]interface{}{
+							"value": "v",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Flatten(testCase.Input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Fatalf(
+					"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+					got,
+					testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenWithSchema(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"bucket_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"items": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"nested": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	apiObject := &autoFlexTestStruct{
+		BucketName: aws.String("test"),
+		Enabled:    aws.Bool(true),
+		Items: []*autoFlexTestNested{
+			{Key: aws.String("a"), Value: aws.String("1")},
+		},
+		Nested: &autoFlexTestNested{Key: aws.String("k"), Value: aws.String("v")},
+	}
+
+	got, err := FlattenWithSchema(apiObject, s)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"bucket_name": "test",
+		"items": []interface{}{
+			map[string]interface{}{"key": "a"},
+		},
+		"nested": []interface{}{
+			map[string]interface{}{"value": "v"},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestFlattenInvalidSource(t *testing.T) {
+	if _, err := Flatten(aws.String("test")); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestFlattenList(t *testing.T) {
+	got, err := FlattenList((*autoFlexTestNested)(nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []interface{}{}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+
+	got, err = FlattenList(&autoFlexTestNested{Key: aws.String("k")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"key": "k",
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestExpandFlattenRoundTrip(t *testing.T) {
+	tfMap := map[string]interface{}{
+		"bucket_name": "test",
+		"created_at":  "2021-11-01T12:30:00Z",
+		"enabled":     true,
+		"max_size":    10,
+		"subnet_ids":  []interface{}{"subnet-1", "subnet-2"},
+		"nested": []interface{}{
+			map[string]interface{}{
+				"key":   "k",
+				"value": "v",
+			},
+		},
+	}
+
+	apiObject := &autoFlexTestStruct{}
+
+	if err := Expand(tfMap, cty.DynamicVal, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := Flatten(apiObject)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, tfMap) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, tfMap)
+	}
+}
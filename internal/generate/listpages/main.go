@@ -362,7 +362,7 @@ func init() {
 	awsServiceNames["apigatewaymanagement"] = "APIGatewayManagement"
 	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
-	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appflow"] = "Appflow"
 	awsServiceNames["appintegrations"] = "AppIntegrations"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
@@ -449,6 +449,7 @@ func init() {
 	awsServiceNames["elbv2"] = "ELBV2"
 	awsServiceNames["emr"] = "EMR"
 	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["emrserverless"] = "EMRServerless"
 	awsServiceNames["eventbridge"] = "EventBridge"
 	awsServiceNames["expression"] = "Expression"
 	awsServiceNames["finspace"] = "FinSpace"
@@ -510,7 +511,7 @@ func init() {
 	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
 	awsServiceNames["licensemanager"] = "LicenseManager"
 	awsServiceNames["lightsail"] = "Lightsail"
-	awsServiceNames["location"] = "Location"
+	awsServiceNames["locationservice"] = "LocationService"
 	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
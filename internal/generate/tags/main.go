@@ -869,7 +869,7 @@ func init() {
 	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
 	awsServiceNames["apigatewayv2"] = "ApiGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
-	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appflow"] = "Appflow"
 	awsServiceNames["appintegrations"] = "AppIntegrations"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
@@ -956,6 +956,7 @@ func init() {
 	awsServiceNames["elbv2"] = "ELBV2"
 	awsServiceNames["emr"] = "EMR"
 	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["emrserverless"] = "EMRServerless"
 	awsServiceNames["eventbridge"] = "EventBridge"
 	awsServiceNames["expression"] = "Expression"
 	awsServiceNames["finspace"] = "FinSpace"
@@ -1017,7 +1018,7 @@ func init() {
 	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
 	awsServiceNames["licensemanager"] = "LicenseManager"
 	awsServiceNames["lightsail"] = "Lightsail"
-	awsServiceNames["location"] = "Location"
+	awsServiceNames["locationservice"] = "LocationService"
 	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
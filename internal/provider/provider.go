@@ -17,11 +17,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
@@ -51,6 +53,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
@@ -71,8 +74,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
@@ -86,6 +92,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
@@ -96,11 +103,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
@@ -355,6 +364,8 @@ func Provider() *schema.Provider {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_auditmanager_framework": auditmanager.DataSourceFramework(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -398,8 +409,18 @@ func Provider() *schema.Provider {
 
 			"aws_cognito_user_pools": cognitoidp.DataSourceUserPools(),
 
-			"aws_connect_contact_flow": connect.DataSourceContactFlow(),
-			"aws_connect_instance":     connect.DataSourceInstance(),
+			"aws_connect_bot_association":             connect.DataSourceBotAssociation(),
+			"aws_connect_contact_flow":                connect.DataSourceContactFlow(),
+			"aws_connect_hours_of_operation":          connect.DataSourceHoursOfOperation(),
+			"aws_connect_instance":                    connect.DataSourceInstance(),
+			"aws_connect_lambda_function_association": connect.DataSourceLambdaFunctionAssociation(),
+			"aws_connect_prompt":                      connect.DataSourcePrompt(),
+			"aws_connect_queue":                       connect.DataSourceQueue(),
+			"aws_connect_quick_connect":               connect.DataSourceQuickConnect(),
+			"aws_connect_routing_profile":             connect.DataSourceRoutingProfile(),
+			"aws_connect_security_profile":            connect.DataSourceSecurityProfile(),
+			"aws_connect_user":                        connect.DataSourceUser(),
+			"aws_connect_user_hierarchy_structure":    connect.DataSourceUserHierarchyStructure(),
 
 			"aws_cur_report_definition": cur.DataSourceReportDefinition(),
 
@@ -443,7 +464,10 @@ func Provider() *schema.Provider {
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
+			"aws_ec2_transit_gateway_multicast_domain":       ec2.DataSourceTransitGatewayMulticastDomain(),
 			"aws_ec2_transit_gateway_peering_attachment":     ec2.DataSourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_route_table":            ec2.DataSourceTransitGatewayRouteTable(),
 			"aws_ec2_transit_gateway_route_tables":           ec2.DataSourceTransitGatewayRouteTables(),
@@ -479,6 +503,7 @@ func Provider() *schema.Provider {
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
 			"aws_ecr_image":               ecr.DataSourceImage(),
+			"aws_ecr_image_scan_findings": ecr.DataSourceImageScanFindings(),
 			"aws_ecr_repository":          ecr.DataSourceRepository(),
 
 			"aws_ecs_cluster":              ecs.DataSourceCluster(),
@@ -522,6 +547,8 @@ func Provider() *schema.Provider {
 
 			"aws_emr_release_labels": emr.DataSourceReleaseLabels(),
 
+			"aws_emrcontainers_virtual_cluster": emrcontainers.DataSourceVirtualCluster(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.DataSourceDeliveryStream(),
 
 			"aws_globalaccelerator_accelerator": globalaccelerator.DataSourceAccelerator(),
@@ -537,6 +564,8 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":   iam.DataSourceInstanceProfile(),
 			"aws_iam_policy":             iam.DataSourcePolicy(),
 			"aws_iam_policy_document":    iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_lint":        iam.DataSourcePolicyLint(),
+			"aws_iam_policy_simulation":  iam.DataSourcePolicySimulation(),
 			"aws_iam_role":               iam.DataSourceRole(),
 			"aws_iam_roles":              iam.DataSourceRoles(),
 			"aws_iam_server_certificate": iam.DataSourceServerCertificate(),
@@ -582,6 +611,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_function_url":        lambda.DataSourceFunctionURL(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -590,6 +620,13 @@ func Provider() *schema.Provider {
 			"aws_lex_intent":    lexmodels.DataSourceIntent(),
 			"aws_lex_slot_type": lexmodels.DataSourceSlotType(),
 
+			"aws_location_geofence_collection": location.DataSourceGeofenceCollection(),
+			"aws_location_map":                 location.DataSourceMap(),
+			"aws_location_place_index":         location.DataSourcePlaceIndex(),
+			"aws_location_route_calculator":    location.DataSourceRouteCalculator(),
+			"aws_location_tracker":             location.DataSourceTracker(),
+			"aws_location_tracker_association": location.DataSourceTrackerAssociation(),
+
 			"aws_arn":                     meta.DataSourceARN(),
 			"aws_billing_service_account": meta.DataSourceBillingServiceAccount(),
 			"aws_default_tags":            meta.DataSourceDefaultTags(),
@@ -781,6 +818,9 @@ func Provider() *schema.Provider {
 			"aws_appconfig_environment":                  appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version": appconfig.ResourceHostedConfigurationVersion(),
 
+			"aws_appflow_connector_profile": appflow.ResourceConnectorProfile(),
+			"aws_appflow_flow":              appflow.ResourceFlow(),
+
 			"aws_appautoscaling_policy":           appautoscaling.ResourcePolicy(),
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
 			"aws_appautoscaling_target":           appautoscaling.ResourceTarget(),
@@ -816,6 +856,10 @@ func Provider() *schema.Provider {
 			"aws_athena_named_query": athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":   athena.ResourceWorkGroup(),
 
+			"aws_auditmanager_assessment": auditmanager.ResourceAssessment(),
+			"aws_auditmanager_control":    auditmanager.ResourceControl(),
+			"aws_auditmanager_framework":  auditmanager.ResourceFramework(),
+
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
 			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
@@ -867,6 +911,7 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_function":                       cloudfront.ResourceFunction(),
 			"aws_cloudfront_key_group":                      cloudfront.ResourceKeyGroup(),
 			"aws_cloudfront_monitoring_subscription":        cloudfront.ResourceMonitoringSubscription(),
+			"aws_cloudfront_origin_access_control":          cloudfront.ResourceOriginAccessControl(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.ResourceOriginAccessIdentity(),
 			"aws_cloudfront_origin_request_policy":          cloudfront.ResourceOriginRequestPolicy(),
 			"aws_cloudfront_public_key":                     cloudfront.ResourcePublicKey(),
@@ -949,8 +994,17 @@ func Provider() *schema.Provider {
 			"aws_config_organization_managed_rule":     configservice.ResourceOrganizationManagedRule(),
 			"aws_config_remediation_configuration":     configservice.ResourceRemediationConfiguration(),
 
-			"aws_connect_contact_flow": connect.ResourceContactFlow(),
-			"aws_connect_instance":     connect.ResourceInstance(),
+			"aws_connect_bot_association":             connect.ResourceBotAssociation(),
+			"aws_connect_contact_flow":                connect.ResourceContactFlow(),
+			"aws_connect_hours_of_operation":          connect.ResourceHoursOfOperation(),
+			"aws_connect_instance":                    connect.ResourceInstance(),
+			"aws_connect_lambda_function_association": connect.ResourceLambdaFunctionAssociation(),
+			"aws_connect_queue":                       connect.ResourceQueue(),
+			"aws_connect_quick_connect":               connect.ResourceQuickConnect(),
+			"aws_connect_routing_profile":             connect.ResourceRoutingProfile(),
+			"aws_connect_security_profile":            connect.ResourceSecurityProfile(),
+			"aws_connect_user":                        connect.ResourceUser(),
+			"aws_connect_user_hierarchy_structure":    connect.ResourceUserHierarchyStructure(),
 
 			"aws_cur_report_definition": cur.ResourceReportDefinition(),
 
@@ -968,6 +1022,11 @@ func Provider() *schema.Provider {
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
 
+			"aws_detective_graph":                      detective.ResourceGraph(),
+			"aws_detective_invitation_accepter":        detective.ResourceInvitationAccepter(),
+			"aws_detective_member":                     detective.ResourceMember(),
+			"aws_detective_organization_admin_account": detective.ResourceOrganizationAdminAccount(),
+
 			"aws_devicefarm_project": devicefarm.ResourceProject(),
 
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
@@ -1015,110 +1074,128 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                             ec2.ResourceAMI(),
-			"aws_ami_copy":                                        ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                               ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                           ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                             ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                             ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                          ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                  ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                     ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                        ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                             ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                       ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                    ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                               ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":              ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
-			"aws_ec2_host":                                        ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_tag":                                         ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                       ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                       ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                 ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":     ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":     ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":              ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":     ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                    ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                             ec2.ResourceEIP(),
-			"aws_eip_association":                                 ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                        ec2.ResourceFlowLog(),
-			"aws_instance":                                        ec2.ResourceInstance(),
-			"aws_internet_gateway":                                ec2.ResourceInternetGateway(),
-			"aws_key_pair":                                        ec2.ResourceKeyPair(),
-			"aws_launch_template":                                 ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                    ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                     ec2.ResourceNatGateway(),
-			"aws_network_acl":                                     ec2.ResourceNetworkACL(),
-			"aws_network_acl_rule":                                ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                               ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                    ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                 ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                 ec2.ResourcePlacementGroup(),
-			"aws_route":                                           ec2.ResourceRoute(),
-			"aws_route_table":                                     ec2.ResourceRouteTable(),
-			"aws_route_table_association":                         ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                  ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                             ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":               ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                      ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                              ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                           ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                          ec2.ResourceSubnet(),
-			"aws_volume_attachment":                               ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                             ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                    ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                    ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_notification":            ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":            ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_service":                            ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                          ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                   ec2.ResourceVPNGatewayRoutePropagation(),
-
-			"aws_ecr_lifecycle_policy":          ecr.ResourceLifecyclePolicy(),
-			"aws_ecr_registry_policy":           ecr.ResourceRegistryPolicy(),
-			"aws_ecr_replication_configuration": ecr.ResourceReplicationConfiguration(),
-			"aws_ecr_repository":                ecr.ResourceRepository(),
-			"aws_ecr_repository_policy":         ecr.ResourceRepositoryPolicy(),
+			"aws_ami":                                              ec2.ResourceAMI(),
+			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
+			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_instance_state":                               ec2.ResourceInstanceState(),
+			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                    ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_tag":                                          ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                              ec2.ResourceEIP(),
+			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                         ec2.ResourceFlowLog(),
+			"aws_instance":                                         ec2.ResourceInstance(),
+			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
+			"aws_key_pair":                                         ec2.ResourceKeyPair(),
+			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                      ec2.ResourceNatGateway(),
+			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
+			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
+			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
+			"aws_route":                                            ec2.ResourceRoute(),
+			"aws_route_table":                                      ec2.ResourceRouteTable(),
+			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
+			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                           ec2.ResourceSubnet(),
+			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
+			"aws_vpc":                                              ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                         ec2.ResourceVPCIPAM(),
+			"aws_vpc_ipam_pool":                                    ec2.ResourceVPCIPAMPool(),
+			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceVPCIPAMPoolCIDR(),
+			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceVPCIPAMPoolCIDRAllocation(),
+			"aws_vpc_ipam_scope":                                   ec2.ResourceVPCIPAMScope(),
+			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
+
+			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
+			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
+			"aws_ecr_registry_policy":                 ecr.ResourceRegistryPolicy(),
+			"aws_ecr_registry_scanning_configuration": ecr.ResourceRegistryScanningConfiguration(),
+			"aws_ecr_replication_configuration":       ecr.ResourceReplicationConfiguration(),
+			"aws_ecr_repository":                      ecr.ResourceRepository(),
+			"aws_ecr_repository_policy":               ecr.ResourceRepositoryPolicy(),
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
-			"aws_ecs_capacity_provider": ecs.ResourceCapacityProvider(),
-			"aws_ecs_cluster":           ecs.ResourceCluster(),
-			"aws_ecs_service":           ecs.ResourceService(),
-			"aws_ecs_tag":               ecs.ResourceTag(),
-			"aws_ecs_task_definition":   ecs.ResourceTaskDefinition(),
+			"aws_ecs_account_setting_default":    ecs.ResourceAccountSettingDefault(),
+			"aws_ecs_capacity_provider":          ecs.ResourceCapacityProvider(),
+			"aws_ecs_cluster":                    ecs.ResourceCluster(),
+			"aws_ecs_cluster_capacity_providers": ecs.ResourceClusterCapacityProviders(),
+			"aws_ecs_service":                    ecs.ResourceService(),
+			"aws_ecs_tag":                        ecs.ResourceTag(),
+			"aws_ecs_task_definition":            ecs.ResourceTaskDefinition(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
@@ -1182,8 +1259,14 @@ func Provider() *schema.Provider {
 			"aws_emr_managed_scaling_policy": emr.ResourceManagedScalingPolicy(),
 			"aws_emr_security_configuration": emr.ResourceSecurityConfiguration(),
 
+			"aws_emrcontainers_virtual_cluster": emrcontainers.ResourceVirtualCluster(),
+
+			"aws_emrserverless_application": emrserverless.ResourceApplication(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
+			"aws_fis_experiment_template": fis.ResourceExperimentTemplate(),
+
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
 			"aws_fms_policy":        fms.ResourcePolicy(),
 
@@ -1286,6 +1369,12 @@ func Provider() *schema.Provider {
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
 
+			"aws_kendra_data_source":                  kendra.ResourceDataSource(),
+			"aws_kendra_faq":                          kendra.ResourceFaq(),
+			"aws_kendra_index":                        kendra.ResourceIndex(),
+			"aws_kendra_query_suggestions_block_list": kendra.ResourceQuerySuggestionsBlockList(),
+			"aws_kendra_thesaurus":                    kendra.ResourceThesaurus(),
+
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
@@ -1312,6 +1401,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
@@ -1332,6 +1422,13 @@ func Provider() *schema.Provider {
 			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
 
+			"aws_location_geofence_collection": location.ResourceGeofenceCollection(),
+			"aws_location_map":                 location.ResourceMap(),
+			"aws_location_place_index":         location.ResourcePlaceIndex(),
+			"aws_location_route_calculator":    location.ResourceRouteCalculator(),
+			"aws_location_tracker":             location.ResourceTracker(),
+			"aws_location_tracker_association": location.ResourceTrackerAssociation(),
+
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
 
@@ -1350,6 +1447,13 @@ func Provider() *schema.Provider {
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
 
+			"aws_memorydb_acl":             memorydb.ResourceACL(),
+			"aws_memorydb_cluster":         memorydb.ResourceCluster(),
+			"aws_memorydb_parameter_group": memorydb.ResourceParameterGroup(),
+			"aws_memorydb_snapshot":        memorydb.ResourceSnapshot(),
+			"aws_memorydb_subnet_group":    memorydb.ResourceSubnetGroup(),
+			"aws_memorydb_user":            memorydb.ResourceUser(),
+
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
 
@@ -1418,25 +1522,27 @@ func Provider() *schema.Provider {
 			"aws_ram_resource_share":          ram.ResourceResourceShare(),
 			"aws_ram_resource_share_accepter": ram.ResourceResourceShareAccepter(),
 
-			"aws_db_cluster_snapshot":           rds.ResourceClusterSnapshot(),
-			"aws_db_event_subscription":         rds.ResourceEventSubscription(),
-			"aws_db_instance":                   rds.ResourceInstance(),
-			"aws_db_instance_role_association":  rds.ResourceInstanceRoleAssociation(),
-			"aws_db_option_group":               rds.ResourceOptionGroup(),
-			"aws_db_parameter_group":            rds.ResourceParameterGroup(),
-			"aws_db_proxy":                      rds.ResourceProxy(),
-			"aws_db_proxy_default_target_group": rds.ResourceProxyDefaultTargetGroup(),
-			"aws_db_proxy_endpoint":             rds.ResourceProxyEndpoint(),
-			"aws_db_proxy_target":               rds.ResourceProxyTarget(),
-			"aws_db_security_group":             rds.ResourceSecurityGroup(),
-			"aws_db_snapshot":                   rds.ResourceSnapshot(),
-			"aws_db_subnet_group":               rds.ResourceSubnetGroup(),
-			"aws_rds_cluster":                   rds.ResourceCluster(),
-			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(),
-			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
-			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
-			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
-			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
+			"aws_db_cluster_snapshot":                       rds.ResourceClusterSnapshot(),
+			"aws_db_event_subscription":                     rds.ResourceEventSubscription(),
+			"aws_db_instance":                               rds.ResourceInstance(),
+			"aws_db_instance_automated_backups_replication": rds.ResourceInstanceAutomatedBackupsReplication(),
+			"aws_db_instance_role_association":              rds.ResourceInstanceRoleAssociation(),
+			"aws_db_option_group":                           rds.ResourceOptionGroup(),
+			"aws_db_parameter_group":                        rds.ResourceParameterGroup(),
+			"aws_db_proxy":                                  rds.ResourceProxy(),
+			"aws_db_proxy_default_target_group":             rds.ResourceProxyDefaultTargetGroup(),
+			"aws_db_proxy_endpoint":                         rds.ResourceProxyEndpoint(),
+			"aws_db_proxy_target":                           rds.ResourceProxyTarget(),
+			"aws_db_security_group":                         rds.ResourceSecurityGroup(),
+			"aws_db_snapshot":                               rds.ResourceSnapshot(),
+			"aws_db_subnet_group":                           rds.ResourceSubnetGroup(),
+			"aws_rds_cluster":                               rds.ResourceCluster(),
+			"aws_rds_cluster_endpoint":                      rds.ResourceClusterEndpoint(),
+			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
+			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
+			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":                           rds.ResourceExportTask(),
+			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
 
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
 			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
@@ -1456,6 +1562,8 @@ func Provider() *schema.Provider {
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_traffic_policy":                route53.ResourceTrafficPolicy(),
+			"aws_route53_traffic_policy_instance":       route53.ResourceTrafficPolicyInstance(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
@@ -1482,18 +1590,28 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_accelerate_configuration":             s3.ResourceBucketAccelerateConfiguration(),
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
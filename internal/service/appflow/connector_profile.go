@@ -0,0 +1,978 @@
+package appflow
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnectorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConnectorProfileCreate,
+		Read:   resourceConnectorProfileRead,
+		Update: resourceConnectorProfileUpdate,
+		Delete: resourceConnectorProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appflow.ConnectionMode_Values(), false),
+			},
+			"connector_label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][\w!@#.-]+$`), "must begin with a letter or number and contain only alphanumeric and !@#.-_ characters"),
+				),
+			},
+			"connector_profile_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_profile_credentials": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amplitude": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"api_key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"secret_key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+									"datadog": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"api_key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"application_key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"google_analytics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+												"client_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"client_secret": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"oauth_request": connectorOAuthRequestSchema(),
+												"refresh_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+											},
+										},
+									},
+									"marketo": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+												"client_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"client_secret": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"oauth_request": connectorOAuthRequestSchema(),
+											},
+										},
+									},
+									"redshift": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"password": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"username": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+												"client_credentials_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"oauth_request": connectorOAuthRequestSchema(),
+												"refresh_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+											},
+										},
+									},
+									"service_now": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"password": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"username": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"slack": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+												"client_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"client_secret": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"oauth_request": connectorOAuthRequestSchema(),
+											},
+										},
+									},
+									"snowflake": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"password": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"username": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"zendesk": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+												"client_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"client_secret": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"oauth_request": connectorOAuthRequestSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"connector_profile_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amplitude": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+									"datadog": connectorInstanceURLPropertiesSchema(),
+									"google_analytics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+									"marketo": connectorInstanceURLPropertiesSchema(),
+									"redshift": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 128),
+												},
+												"database_url": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_url": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"is_sandbox_environment": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"service_now": connectorInstanceURLPropertiesSchema(),
+									"slack":       connectorInstanceURLPropertiesSchema(),
+									"snowflake": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 128),
+												},
+												"private_link_service_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"region": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"stage": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"warehouse": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"zendesk": connectorInstanceURLPropertiesSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"connector_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appflow.ConnectorType_Values(), false),
+			},
+			"credentials_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[\w/!@#+=.-]+$`), "must contain only alphanumeric and /!@#+=.-_ characters"),
+				),
+			},
+		},
+	}
+}
+
+func connectorOAuthRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auth_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+				"redirect_uri": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
+		},
+	}
+}
+
+func connectorInstanceURLPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourceConnectorProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	name := d.Get("name").(string)
+	input := &appflow.CreateConnectorProfileInput{
+		ConnectionMode:       aws.String(d.Get("connection_mode").(string)),
+		ConnectorProfileName: aws.String(name),
+		ConnectorType:        aws.String(d.Get("connector_type").(string)),
+	}
+
+	if v, ok := d.GetOk("connector_label"); ok {
+		input.ConnectorLabel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("connector_profile_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConnectorProfileConfig = expandConnectorProfileConfig(v.([]interface{})[0].(map[string]interface{}), d.Get("connector_type").(string))
+	}
+
+	if v, ok := d.GetOk("kms_arn"); ok {
+		input.KmsArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating AppFlow Connector Profile: %s", input)
+	_, err := conn.CreateConnectorProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppFlow Connector Profile (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceConnectorProfileRead(d, meta)
+}
+
+func resourceConnectorProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	connectorProfile, err := FindConnectorProfileByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFlow Connector Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppFlow Connector Profile (%s): %w", d.Id(), err)
+	}
+
+	// Credentials are never returned by the API, so they are preserved from configuration.
+	var credentials interface{}
+	if v, ok := d.GetOk("connector_profile_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		credentials = v.([]interface{})[0].(map[string]interface{})["connector_profile_credentials"]
+	}
+
+	d.Set("arn", connectorProfile.ConnectorProfileArn)
+	d.Set("connection_mode", connectorProfile.ConnectionMode)
+	d.Set("connector_label", connectorProfile.ConnectorLabel)
+	if err := d.Set("connector_profile_config", []interface{}{map[string]interface{}{
+		"connector_profile_credentials": credentials,
+		"connector_profile_properties":  flattenConnectorProfileProperties(connectorProfile.ConnectorProfileProperties),
+	}}); err != nil {
+		return fmt.Errorf("error setting connector_profile_config: %w", err)
+	}
+	d.Set("connector_type", connectorProfile.ConnectorType)
+	d.Set("credentials_arn", connectorProfile.CredentialsArn)
+	d.Set("name", connectorProfile.ConnectorProfileName)
+
+	return nil
+}
+
+func resourceConnectorProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	input := &appflow.UpdateConnectorProfileInput{
+		ConnectionMode:       aws.String(d.Get("connection_mode").(string)),
+		ConnectorProfileName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("connector_profile_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConnectorProfileConfig = expandConnectorProfileConfig(v.([]interface{})[0].(map[string]interface{}), d.Get("connector_type").(string))
+	}
+
+	log.Printf("[DEBUG] Updating AppFlow Connector Profile: %s", input)
+	_, err := conn.UpdateConnectorProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating AppFlow Connector Profile (%s): %w", d.Id(), err)
+	}
+
+	return resourceConnectorProfileRead(d, meta)
+}
+
+func resourceConnectorProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	log.Printf("[DEBUG] Deleting AppFlow Connector Profile: %s", d.Id())
+	_, err := conn.DeleteConnectorProfile(&appflow.DeleteConnectorProfileInput{
+		ConnectorProfileName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppFlow Connector Profile (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConnectorProfileConfig(tfMap map[string]interface{}, connectorType string) *appflow.ConnectorProfileConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.ConnectorProfileConfig{
+		ConnectorProfileCredentials: &appflow.ConnectorProfileCredentials{},
+		ConnectorProfileProperties:  &appflow.ConnectorProfileProperties{},
+	}
+
+	if v, ok := tfMap["connector_profile_credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConnectorProfileCredentials = expandConnectorProfileCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["connector_profile_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ConnectorProfileProperties = expandConnectorProfileProperties(v[0])
+	}
+
+	// Connectors without any properties are configured with empty blocks, which may not survive expansion.
+	switch connectorType {
+	case appflow.ConnectorTypeAmplitude:
+		if apiObject.ConnectorProfileProperties.Amplitude == nil {
+			apiObject.ConnectorProfileProperties.Amplitude = &appflow.AmplitudeConnectorProfileProperties{}
+		}
+	case appflow.ConnectorTypeGoogleanalytics:
+		if apiObject.ConnectorProfileProperties.GoogleAnalytics == nil {
+			apiObject.ConnectorProfileProperties.GoogleAnalytics = &appflow.GoogleAnalyticsConnectorProfileProperties{}
+		}
+	}
+
+	return apiObject
+}
+
+func expandConnectorProfileCredentials(tfMap map[string]interface{}) *appflow.ConnectorProfileCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.ConnectorProfileCredentials{}
+
+	if v, ok := tfMap["amplitude"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Amplitude = &appflow.AmplitudeConnectorProfileCredentials{
+			ApiKey:    aws.String(tfMap["api_key"].(string)),
+			SecretKey: aws.String(tfMap["secret_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["datadog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Datadog = &appflow.DatadogConnectorProfileCredentials{
+			ApiKey:         aws.String(tfMap["api_key"].(string)),
+			ApplicationKey: aws.String(tfMap["application_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["google_analytics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.GoogleAnalytics = &appflow.GoogleAnalyticsConnectorProfileCredentials{
+			ClientId:     aws.String(tfMap["client_id"].(string)),
+			ClientSecret: aws.String(tfMap["client_secret"].(string)),
+		}
+
+		if v, ok := tfMap["access_token"].(string); ok && v != "" {
+			apiObject.GoogleAnalytics.AccessToken = aws.String(v)
+		}
+
+		if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GoogleAnalytics.OAuthRequest = expandConnectorOAuthRequest(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["refresh_token"].(string); ok && v != "" {
+			apiObject.GoogleAnalytics.RefreshToken = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["marketo"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Marketo = &appflow.MarketoConnectorProfileCredentials{
+			ClientId:     aws.String(tfMap["client_id"].(string)),
+			ClientSecret: aws.String(tfMap["client_secret"].(string)),
+		}
+
+		if v, ok := tfMap["access_token"].(string); ok && v != "" {
+			apiObject.Marketo.AccessToken = aws.String(v)
+		}
+
+		if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Marketo.OAuthRequest = expandConnectorOAuthRequest(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Redshift = &appflow.RedshiftConnectorProfileCredentials{
+			Password: aws.String(tfMap["password"].(string)),
+			Username: aws.String(tfMap["username"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceConnectorProfileCredentials{}
+
+		if v, ok := tfMap["access_token"].(string); ok && v != "" {
+			apiObject.Salesforce.AccessToken = aws.String(v)
+		}
+
+		if v, ok := tfMap["client_credentials_arn"].(string); ok && v != "" {
+			apiObject.Salesforce.ClientCredentialsArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Salesforce.OAuthRequest = expandConnectorOAuthRequest(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["refresh_token"].(string); ok && v != "" {
+			apiObject.Salesforce.RefreshToken = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_now"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ServiceNow = &appflow.ServiceNowConnectorProfileCredentials{
+			Password: aws.String(tfMap["password"].(string)),
+			Username: aws.String(tfMap["username"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["slack"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Slack = &appflow.SlackConnectorProfileCredentials{
+			ClientId:     aws.String(tfMap["client_id"].(string)),
+			ClientSecret: aws.String(tfMap["client_secret"].(string)),
+		}
+
+		if v, ok := tfMap["access_token"].(string); ok && v != "" {
+			apiObject.Slack.AccessToken = aws.String(v)
+		}
+
+		if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Slack.OAuthRequest = expandConnectorOAuthRequest(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["snowflake"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Snowflake = &appflow.SnowflakeConnectorProfileCredentials{
+			Password: aws.String(tfMap["password"].(string)),
+			Username: aws.String(tfMap["username"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["zendesk"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Zendesk = &appflow.ZendeskConnectorProfileCredentials{
+			ClientId:     aws.String(tfMap["client_id"].(string)),
+			ClientSecret: aws.String(tfMap["client_secret"].(string)),
+		}
+
+		if v, ok := tfMap["access_token"].(string); ok && v != "" {
+			apiObject.Zendesk.AccessToken = aws.String(v)
+		}
+
+		if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Zendesk.OAuthRequest = expandConnectorOAuthRequest(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandConnectorOAuthRequest(tfMap map[string]interface{}) *appflow.ConnectorOAuthRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.ConnectorOAuthRequest{}
+
+	if v, ok := tfMap["auth_code"].(string); ok && v != "" {
+		apiObject.AuthCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["redirect_uri"].(string); ok && v != "" {
+		apiObject.RedirectUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandConnectorProfileProperties(tfRaw interface{}) *appflow.ConnectorProfileProperties {
+	apiObject := &appflow.ConnectorProfileProperties{}
+
+	tfMap, ok := tfRaw.(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["amplitude"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Amplitude = &appflow.AmplitudeConnectorProfileProperties{}
+	}
+
+	if v, ok := tfMap["datadog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Datadog = &appflow.DatadogConnectorProfileProperties{
+			InstanceUrl: aws.String(v[0].(map[string]interface{})["instance_url"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["google_analytics"].([]interface{}); ok && len(v) > 0 {
+		apiObject.GoogleAnalytics = &appflow.GoogleAnalyticsConnectorProfileProperties{}
+	}
+
+	if v, ok := tfMap["marketo"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Marketo = &appflow.MarketoConnectorProfileProperties{
+			InstanceUrl: aws.String(v[0].(map[string]interface{})["instance_url"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Redshift = &appflow.RedshiftConnectorProfileProperties{
+			BucketName:  aws.String(tfMap["bucket_name"].(string)),
+			DatabaseUrl: aws.String(tfMap["database_url"].(string)),
+			RoleArn:     aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Redshift.BucketPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Salesforce = &appflow.SalesforceConnectorProfileProperties{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["instance_url"].(string); ok && v != "" {
+				apiObject.Salesforce.InstanceUrl = aws.String(v)
+			}
+
+			if v, ok := tfMap["is_sandbox_environment"].(bool); ok {
+				apiObject.Salesforce.IsSandboxEnvironment = aws.Bool(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["service_now"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ServiceNow = &appflow.ServiceNowConnectorProfileProperties{
+			InstanceUrl: aws.String(v[0].(map[string]interface{})["instance_url"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["slack"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Slack = &appflow.SlackConnectorProfileProperties{
+			InstanceUrl: aws.String(v[0].(map[string]interface{})["instance_url"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["snowflake"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Snowflake = &appflow.SnowflakeConnectorProfileProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+			Stage:      aws.String(tfMap["stage"].(string)),
+			Warehouse:  aws.String(tfMap["warehouse"].(string)),
+		}
+
+		if v, ok := tfMap["account_name"].(string); ok && v != "" {
+			apiObject.Snowflake.AccountName = aws.String(v)
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Snowflake.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["private_link_service_name"].(string); ok && v != "" {
+			apiObject.Snowflake.PrivateLinkServiceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Snowflake.Region = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["zendesk"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Zendesk = &appflow.ZendeskConnectorProfileProperties{
+			InstanceUrl: aws.String(v[0].(map[string]interface{})["instance_url"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenConnectorProfileProperties(apiObject *appflow.ConnectorProfileProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.Amplitude != nil {
+		tfMap["amplitude"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.Datadog; v != nil {
+		tfMap["datadog"] = []interface{}{map[string]interface{}{
+			"instance_url": aws.StringValue(v.InstanceUrl),
+		}}
+	}
+
+	if apiObject.GoogleAnalytics != nil {
+		tfMap["google_analytics"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.Marketo; v != nil {
+		tfMap["marketo"] = []interface{}{map[string]interface{}{
+			"instance_url": aws.StringValue(v.InstanceUrl),
+		}}
+	}
+
+	if v := apiObject.Redshift; v != nil {
+		tfMap["redshift"] = []interface{}{map[string]interface{}{
+			"bucket_name":   aws.StringValue(v.BucketName),
+			"bucket_prefix": aws.StringValue(v.BucketPrefix),
+			"database_url":  aws.StringValue(v.DatabaseUrl),
+			"role_arn":      aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"instance_url":           aws.StringValue(v.InstanceUrl),
+			"is_sandbox_environment": aws.BoolValue(v.IsSandboxEnvironment),
+		}}
+	}
+
+	if v := apiObject.ServiceNow; v != nil {
+		tfMap["service_now"] = []interface{}{map[string]interface{}{
+			"instance_url": aws.StringValue(v.InstanceUrl),
+		}}
+	}
+
+	if v := apiObject.Slack; v != nil {
+		tfMap["slack"] = []interface{}{map[string]interface{}{
+			"instance_url": aws.StringValue(v.InstanceUrl),
+		}}
+	}
+
+	if v := apiObject.Snowflake; v != nil {
+		tfMap["snowflake"] = []interface{}{map[string]interface{}{
+			"account_name":              aws.StringValue(v.AccountName),
+			"bucket_name":               aws.StringValue(v.BucketName),
+			"bucket_prefix":             aws.StringValue(v.BucketPrefix),
+			"private_link_service_name": aws.StringValue(v.PrivateLinkServiceName),
+			"region":                    aws.StringValue(v.Region),
+			"stage":                     aws.StringValue(v.Stage),
+			"warehouse":                 aws.StringValue(v.Warehouse),
+		}}
+	}
+
+	if v := apiObject.Zendesk; v != nil {
+		tfMap["zendesk"] = []interface{}{map[string]interface{}{
+			"instance_url": aws.StringValue(v.InstanceUrl),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
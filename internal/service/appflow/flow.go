@@ -0,0 +1,1505 @@
+package appflow
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFlowCreate,
+		Read:   resourceFlowRead,
+		Update: resourceFlowUpdate,
+		Delete: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"destination_flow_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.ConnectorType_Values(), false),
+						},
+						"destination_connector_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_bridge": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"error_handling_config": flowErrorHandlingConfigSchema(),
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"redshift": flowIntermediateBucketDestinationSchema(),
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 512),
+												},
+												"s3_output_format_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aggregation_config": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"aggregation_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.AggregationType_Values(), false),
+																		},
+																	},
+																},
+															},
+															"file_type": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(appflow.FileType_Values(), false),
+															},
+															"prefix_config": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"prefix_format": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.PrefixFormat_Values(), false),
+																		},
+																		"prefix_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.PrefixType_Values(), false),
+																		},
+																	},
+																},
+															},
+															"preserve_source_data_typing": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"error_handling_config": flowErrorHandlingConfigSchema(),
+												"id_field_names": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(0, 128),
+													},
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"write_operation_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(appflow.WriteOperationType_Values(), false),
+												},
+											},
+										},
+									},
+									"snowflake": flowIntermediateBucketDestinationSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"flow_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{appflow.FlowStatusActive, appflow.FlowStatusSuspended}, false),
+			},
+			"kms_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][\w!@#.-]+$`), "must begin with a letter or number and contain only alphanumeric and !@#.-_ characters"),
+				),
+			},
+			"source_flow_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.ConnectorType_Values(), false),
+						},
+						"incremental_pull_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datetime_type_field_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+								},
+							},
+						},
+						"source_connector_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amplitude":        flowObjectSourceSchema(),
+									"datadog":          flowObjectSourceSchema(),
+									"google_analytics": flowObjectSourceSchema(),
+									"marketo":          flowObjectSourceSchema(),
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 512),
+												},
+												"s3_input_format_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"s3_input_file_type": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(appflow.S3InputFileType_Values(), false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable_dynamic_field_update": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"include_deleted_records": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"service_now": flowObjectSourceSchema(),
+									"slack":       flowObjectSourceSchema(),
+									"zendesk":     flowObjectSourceSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"task": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_operator": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amplitude": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.AmplitudeConnectorOperator_Values(), false),
+									},
+									"datadog": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.DatadogConnectorOperator_Values(), false),
+									},
+									"google_analytics": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.GoogleAnalyticsConnectorOperator_Values(), false),
+									},
+									"marketo": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.MarketoConnectorOperator_Values(), false),
+									},
+									"s3": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.S3ConnectorOperator_Values(), false),
+									},
+									"salesforce": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.SalesforceConnectorOperator_Values(), false),
+									},
+									"service_now": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.ServiceNowConnectorOperator_Values(), false),
+									},
+									"slack": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.SlackConnectorOperator_Values(), false),
+									},
+									"zendesk": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.ZendeskConnectorOperator_Values(), false),
+									},
+								},
+							},
+						},
+						"destination_field": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+						},
+						"source_fields": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 2048),
+							},
+						},
+						"task_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 2048),
+							},
+						},
+						"task_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.TaskType_Values(), false),
+						},
+					},
+				},
+			},
+			"trigger_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scheduled": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"data_pull_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(appflow.DataPullMode_Values(), false),
+												},
+												"first_execution_from": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+												"schedule_end_time": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+												"schedule_expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"schedule_offset": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 36000),
+												},
+												"schedule_start_time": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+												"timezone": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 256),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"trigger_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.TriggerType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func flowErrorHandlingConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"bucket_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+				"fail_on_first_destination_error": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func flowIntermediateBucketDestinationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+				"error_handling_config": flowErrorHandlingConfigSchema(),
+				"intermediate_bucket_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"object": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
+		},
+	}
+}
+
+func flowObjectSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
+		},
+	}
+}
+
+func resourceFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appflow.CreateFlowInput{
+		DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
+		FlowName:                  aws.String(name),
+		Tasks:                     expandTasks(d.Get("task").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_arn"); ok {
+		input.KmsArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_flow_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFlowConfig = expandSourceFlowConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("trigger_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TriggerConfig = expandTriggerConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AppFlow Flow: %s", input)
+	_, err := conn.CreateFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppFlow Flow (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := updateFlowStatus(conn, d); err != nil {
+		return err
+	}
+
+	return resourceFlowRead(d, meta)
+}
+
+func resourceFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	flow, err := FindFlowByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFlow Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppFlow Flow (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("description", flow.Description)
+	if err := d.Set("destination_flow_config", flattenDestinationFlowConfigs(flow.DestinationFlowConfigList)); err != nil {
+		return fmt.Errorf("error setting destination_flow_config: %w", err)
+	}
+	d.Set("flow_status", flow.FlowStatus)
+	d.Set("kms_arn", flow.KmsArn)
+	d.Set("name", flow.FlowName)
+	if err := d.Set("source_flow_config", flattenSourceFlowConfig(flow.SourceFlowConfig)); err != nil {
+		return fmt.Errorf("error setting source_flow_config: %w", err)
+	}
+	if err := d.Set("task", flattenTasks(flow.Tasks)); err != nil {
+		return fmt.Errorf("error setting task: %w", err)
+	}
+	if err := d.Set("trigger_config", flattenTriggerConfig(flow.TriggerConfig)); err != nil {
+		return fmt.Errorf("error setting trigger_config: %w", err)
+	}
+
+	tags := KeyValueTags(flow.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	if d.HasChangesExcept("flow_status", "tags", "tags_all") {
+		input := &appflow.UpdateFlowInput{
+			Description:               aws.String(d.Get("description").(string)),
+			DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
+			FlowName:                  aws.String(d.Id()),
+			Tasks:                     expandTasks(d.Get("task").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("source_flow_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SourceFlowConfig = expandSourceFlowConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("trigger_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.TriggerConfig = expandTriggerConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating AppFlow Flow: %s", input)
+		_, err := conn.UpdateFlow(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppFlow Flow (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("flow_status") {
+		if err := updateFlowStatus(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppFlow Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFlowRead(d, meta)
+}
+
+func resourceFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	log.Printf("[DEBUG] Deleting AppFlow Flow: %s", d.Id())
+	_, err := conn.DeleteFlow(&appflow.DeleteFlowInput{
+		FlowName:    aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppFlow Flow (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateFlowStatus activates or suspends a scheduled or event-triggered flow.
+// Starting an on-demand flow runs it, so flow_status is ignored for those flows.
+func updateFlowStatus(conn *appflow.Appflow, d *schema.ResourceData) error {
+	status, ok := d.GetOk("flow_status")
+
+	if !ok || d.Get("trigger_config.0.trigger_type").(string) == appflow.TriggerTypeOnDemand {
+		return nil
+	}
+
+	switch status.(string) {
+	case appflow.FlowStatusActive:
+		log.Printf("[DEBUG] Starting AppFlow Flow: %s", d.Id())
+		_, err := conn.StartFlow(&appflow.StartFlowInput{
+			FlowName: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting AppFlow Flow (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitFlowActivated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for AppFlow Flow (%s) activation: %w", d.Id(), err)
+		}
+	case appflow.FlowStatusSuspended:
+		flow, err := FindFlowByName(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading AppFlow Flow (%s): %w", d.Id(), err)
+		}
+
+		// Only an active flow can be stopped.
+		if aws.StringValue(flow.FlowStatus) != appflow.FlowStatusActive {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Stopping AppFlow Flow: %s", d.Id())
+		_, err = conn.StopFlow(&appflow.StopFlowInput{
+			FlowName: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping AppFlow Flow (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitFlowSuspended(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for AppFlow Flow (%s) suspension: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandDestinationFlowConfigs(tfList []interface{}) []*appflow.DestinationFlowConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appflow.DestinationFlowConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appflow.DestinationFlowConfig{
+			ConnectorType:                  aws.String(tfMap["connector_type"].(string)),
+			DestinationConnectorProperties: &appflow.DestinationConnectorProperties{},
+		}
+
+		if v, ok := tfMap["api_version"].(string); ok && v != "" {
+			apiObject.ApiVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+			apiObject.ConnectorProfileName = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination_connector_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DestinationConnectorProperties = expandDestinationConnectorProperties(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDestinationConnectorProperties(tfMap map[string]interface{}) *appflow.DestinationConnectorProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.DestinationConnectorProperties{}
+
+	if v, ok := tfMap["event_bridge"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EventBridge = &appflow.EventBridgeDestinationProperties{
+			Object: aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EventBridge.ErrorHandlingConfig = expandErrorHandlingConfig(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Redshift = &appflow.RedshiftDestinationProperties{
+			IntermediateBucketName: aws.String(tfMap["intermediate_bucket_name"].(string)),
+			Object:                 aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Redshift.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Redshift.ErrorHandlingConfig = expandErrorHandlingConfig(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &appflow.S3DestinationProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.S3.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["s3_output_format_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3.S3OutputFormatConfig = expandS3OutputFormatConfig(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceDestinationProperties{
+			Object: aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Salesforce.ErrorHandlingConfig = expandErrorHandlingConfig(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["id_field_names"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Salesforce.IdFieldNames = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["write_operation_type"].(string); ok && v != "" {
+			apiObject.Salesforce.WriteOperationType = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["snowflake"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Snowflake = &appflow.SnowflakeDestinationProperties{
+			IntermediateBucketName: aws.String(tfMap["intermediate_bucket_name"].(string)),
+			Object:                 aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Snowflake.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Snowflake.ErrorHandlingConfig = expandErrorHandlingConfig(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandErrorHandlingConfig(tfMap map[string]interface{}) *appflow.ErrorHandlingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.ErrorHandlingConfig{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+		apiObject.BucketPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["fail_on_first_destination_error"].(bool); ok {
+		apiObject.FailOnFirstDestinationError = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandS3OutputFormatConfig(tfMap map[string]interface{}) *appflow.S3OutputFormatConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.S3OutputFormatConfig{}
+
+	if v, ok := tfMap["aggregation_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AggregationConfig = &appflow.AggregationConfig{}
+
+		if v, ok := v[0].(map[string]interface{})["aggregation_type"].(string); ok && v != "" {
+			apiObject.AggregationConfig.AggregationType = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["file_type"].(string); ok && v != "" {
+		apiObject.FileType = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.PrefixConfig = &appflow.PrefixConfig{}
+
+		if v, ok := tfMap["prefix_format"].(string); ok && v != "" {
+			apiObject.PrefixConfig.PrefixFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix_type"].(string); ok && v != "" {
+			apiObject.PrefixConfig.PrefixType = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["preserve_source_data_typing"].(bool); ok {
+		apiObject.PreserveSourceDataTyping = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceFlowConfig(tfMap map[string]interface{}) *appflow.SourceFlowConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.SourceFlowConfig{
+		ConnectorType:             aws.String(tfMap["connector_type"].(string)),
+		SourceConnectorProperties: &appflow.SourceConnectorProperties{},
+	}
+
+	if v, ok := tfMap["api_version"].(string); ok && v != "" {
+		apiObject.ApiVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+		apiObject.ConnectorProfileName = aws.String(v)
+	}
+
+	if v, ok := tfMap["incremental_pull_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IncrementalPullConfig = &appflow.IncrementalPullConfig{}
+
+		if v, ok := v[0].(map[string]interface{})["datetime_type_field_name"].(string); ok && v != "" {
+			apiObject.IncrementalPullConfig.DatetimeTypeFieldName = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["source_connector_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourceConnectorProperties = expandSourceConnectorProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSourceConnectorProperties(tfMap map[string]interface{}) *appflow.SourceConnectorProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.SourceConnectorProperties{}
+
+	if v := expandFlowSourceObject(tfMap["amplitude"]); v != nil {
+		apiObject.Amplitude = &appflow.AmplitudeSourceProperties{Object: v}
+	}
+
+	if v := expandFlowSourceObject(tfMap["datadog"]); v != nil {
+		apiObject.Datadog = &appflow.DatadogSourceProperties{Object: v}
+	}
+
+	if v := expandFlowSourceObject(tfMap["google_analytics"]); v != nil {
+		apiObject.GoogleAnalytics = &appflow.GoogleAnalyticsSourceProperties{Object: v}
+	}
+
+	if v := expandFlowSourceObject(tfMap["marketo"]); v != nil {
+		apiObject.Marketo = &appflow.MarketoSourceProperties{Object: v}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &appflow.S3SourceProperties{
+			BucketName:   aws.String(tfMap["bucket_name"].(string)),
+			BucketPrefix: aws.String(tfMap["bucket_prefix"].(string)),
+		}
+
+		if v, ok := tfMap["s3_input_format_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3.S3InputFormatConfig = &appflow.S3InputFormatConfig{}
+
+			if v, ok := v[0].(map[string]interface{})["s3_input_file_type"].(string); ok && v != "" {
+				apiObject.S3.S3InputFormatConfig.S3InputFileType = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceSourceProperties{
+			EnableDynamicFieldUpdate: aws.Bool(tfMap["enable_dynamic_field_update"].(bool)),
+			IncludeDeletedRecords:    aws.Bool(tfMap["include_deleted_records"].(bool)),
+			Object:                   aws.String(tfMap["object"].(string)),
+		}
+	}
+
+	if v := expandFlowSourceObject(tfMap["service_now"]); v != nil {
+		apiObject.ServiceNow = &appflow.ServiceNowSourceProperties{Object: v}
+	}
+
+	if v := expandFlowSourceObject(tfMap["slack"]); v != nil {
+		apiObject.Slack = &appflow.SlackSourceProperties{Object: v}
+	}
+
+	if v := expandFlowSourceObject(tfMap["zendesk"]); v != nil {
+		apiObject.Zendesk = &appflow.ZendeskSourceProperties{Object: v}
+	}
+
+	return apiObject
+}
+
+func expandFlowSourceObject(tfRaw interface{}) *string {
+	tfList, ok := tfRaw.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return aws.String(tfList[0].(map[string]interface{})["object"].(string))
+}
+
+func expandTasks(tfList []interface{}) []*appflow.Task {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appflow.Task
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appflow.Task{
+			SourceFields: flex.ExpandStringList(tfMap["source_fields"].([]interface{})),
+			TaskType:     aws.String(tfMap["task_type"].(string)),
+		}
+
+		if v, ok := tfMap["connector_operator"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ConnectorOperator = expandConnectorOperator(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["destination_field"].(string); ok && v != "" {
+			apiObject.DestinationField = aws.String(v)
+		}
+
+		if v, ok := tfMap["task_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.TaskProperties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConnectorOperator(tfMap map[string]interface{}) *appflow.ConnectorOperator {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.ConnectorOperator{}
+
+	if v, ok := tfMap["amplitude"].(string); ok && v != "" {
+		apiObject.Amplitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["datadog"].(string); ok && v != "" {
+		apiObject.Datadog = aws.String(v)
+	}
+
+	if v, ok := tfMap["google_analytics"].(string); ok && v != "" {
+		apiObject.GoogleAnalytics = aws.String(v)
+	}
+
+	if v, ok := tfMap["marketo"].(string); ok && v != "" {
+		apiObject.Marketo = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3"].(string); ok && v != "" {
+		apiObject.S3 = aws.String(v)
+	}
+
+	if v, ok := tfMap["salesforce"].(string); ok && v != "" {
+		apiObject.Salesforce = aws.String(v)
+	}
+
+	if v, ok := tfMap["service_now"].(string); ok && v != "" {
+		apiObject.ServiceNow = aws.String(v)
+	}
+
+	if v, ok := tfMap["slack"].(string); ok && v != "" {
+		apiObject.Slack = aws.String(v)
+	}
+
+	if v, ok := tfMap["zendesk"].(string); ok && v != "" {
+		apiObject.Zendesk = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTriggerConfig(tfMap map[string]interface{}) *appflow.TriggerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.TriggerConfig{
+		TriggerType: aws.String(tfMap["trigger_type"].(string)),
+	}
+
+	if v, ok := tfMap["trigger_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TriggerProperties = &appflow.TriggerProperties{}
+
+		if v, ok := tfMap["scheduled"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TriggerProperties.Scheduled = expandScheduledTriggerProperties(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduledTriggerProperties(tfMap map[string]interface{}) *appflow.ScheduledTriggerProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appflow.ScheduledTriggerProperties{
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+	}
+
+	if v, ok := tfMap["data_pull_mode"].(string); ok && v != "" {
+		apiObject.DataPullMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.FirstExecutionFrom = aws.Time(t)
+	}
+
+	if v, ok := tfMap["schedule_end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ScheduleEndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["schedule_offset"].(int); ok && v != 0 {
+		apiObject.ScheduleOffset = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["schedule_start_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ScheduleStartTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDestinationFlowConfigs(apiObjects []*appflow.DestinationFlowConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"api_version":            aws.StringValue(apiObject.ApiVersion),
+			"connector_profile_name": aws.StringValue(apiObject.ConnectorProfileName),
+			"connector_type":         aws.StringValue(apiObject.ConnectorType),
+		}
+
+		if v := apiObject.DestinationConnectorProperties; v != nil {
+			tfMap["destination_connector_properties"] = flattenDestinationConnectorProperties(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDestinationConnectorProperties(apiObject *appflow.DestinationConnectorProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EventBridge; v != nil {
+		tfMap["event_bridge"] = []interface{}{map[string]interface{}{
+			"error_handling_config": flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"object":                aws.StringValue(v.Object),
+		}}
+	}
+
+	if v := apiObject.Redshift; v != nil {
+		tfMap["redshift"] = []interface{}{map[string]interface{}{
+			"bucket_prefix":            aws.StringValue(v.BucketPrefix),
+			"error_handling_config":    flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"intermediate_bucket_name": aws.StringValue(v.IntermediateBucketName),
+			"object":                   aws.StringValue(v.Object),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":             aws.StringValue(v.BucketName),
+			"bucket_prefix":           aws.StringValue(v.BucketPrefix),
+			"s3_output_format_config": flattenS3OutputFormatConfig(v.S3OutputFormatConfig),
+		}}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"error_handling_config": flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"id_field_names":        aws.StringValueSlice(v.IdFieldNames),
+			"object":                aws.StringValue(v.Object),
+			"write_operation_type":  aws.StringValue(v.WriteOperationType),
+		}}
+	}
+
+	if v := apiObject.Snowflake; v != nil {
+		tfMap["snowflake"] = []interface{}{map[string]interface{}{
+			"bucket_prefix":            aws.StringValue(v.BucketPrefix),
+			"error_handling_config":    flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"intermediate_bucket_name": aws.StringValue(v.IntermediateBucketName),
+			"object":                   aws.StringValue(v.Object),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenErrorHandlingConfig(apiObject *appflow.ErrorHandlingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name":                     aws.StringValue(apiObject.BucketName),
+		"bucket_prefix":                   aws.StringValue(apiObject.BucketPrefix),
+		"fail_on_first_destination_error": aws.BoolValue(apiObject.FailOnFirstDestinationError),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3OutputFormatConfig(apiObject *appflow.S3OutputFormatConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"file_type":                   aws.StringValue(apiObject.FileType),
+		"preserve_source_data_typing": aws.BoolValue(apiObject.PreserveSourceDataTyping),
+	}
+
+	if v := apiObject.AggregationConfig; v != nil {
+		tfMap["aggregation_config"] = []interface{}{map[string]interface{}{
+			"aggregation_type": aws.StringValue(v.AggregationType),
+		}}
+	}
+
+	if v := apiObject.PrefixConfig; v != nil {
+		tfMap["prefix_config"] = []interface{}{map[string]interface{}{
+			"prefix_format": aws.StringValue(v.PrefixFormat),
+			"prefix_type":   aws.StringValue(v.PrefixType),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSourceFlowConfig(apiObject *appflow.SourceFlowConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"api_version":            aws.StringValue(apiObject.ApiVersion),
+		"connector_profile_name": aws.StringValue(apiObject.ConnectorProfileName),
+		"connector_type":         aws.StringValue(apiObject.ConnectorType),
+	}
+
+	if v := apiObject.IncrementalPullConfig; v != nil {
+		tfMap["incremental_pull_config"] = []interface{}{map[string]interface{}{
+			"datetime_type_field_name": aws.StringValue(v.DatetimeTypeFieldName),
+		}}
+	}
+
+	if v := apiObject.SourceConnectorProperties; v != nil {
+		tfMap["source_connector_properties"] = flattenSourceConnectorProperties(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSourceConnectorProperties(apiObject *appflow.SourceConnectorProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amplitude; v != nil {
+		tfMap["amplitude"] = flattenFlowSourceObject(v.Object)
+	}
+
+	if v := apiObject.Datadog; v != nil {
+		tfMap["datadog"] = flattenFlowSourceObject(v.Object)
+	}
+
+	if v := apiObject.GoogleAnalytics; v != nil {
+		tfMap["google_analytics"] = flattenFlowSourceObject(v.Object)
+	}
+
+	if v := apiObject.Marketo; v != nil {
+		tfMap["marketo"] = flattenFlowSourceObject(v.Object)
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMapS3 := map[string]interface{}{
+			"bucket_name":   aws.StringValue(v.BucketName),
+			"bucket_prefix": aws.StringValue(v.BucketPrefix),
+		}
+
+		if v := v.S3InputFormatConfig; v != nil {
+			tfMapS3["s3_input_format_config"] = []interface{}{map[string]interface{}{
+				"s3_input_file_type": aws.StringValue(v.S3InputFileType),
+			}}
+		}
+
+		tfMap["s3"] = []interface{}{tfMapS3}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"enable_dynamic_field_update": aws.BoolValue(v.EnableDynamicFieldUpdate),
+			"include_deleted_records":     aws.BoolValue(v.IncludeDeletedRecords),
+			"object":                      aws.StringValue(v.Object),
+		}}
+	}
+
+	if v := apiObject.ServiceNow; v != nil {
+		tfMap["service_now"] = flattenFlowSourceObject(v.Object)
+	}
+
+	if v := apiObject.Slack; v != nil {
+		tfMap["slack"] = flattenFlowSourceObject(v.Object)
+	}
+
+	if v := apiObject.Zendesk; v != nil {
+		tfMap["zendesk"] = flattenFlowSourceObject(v.Object)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFlowSourceObject(object *string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"object": aws.StringValue(object),
+	}}
+}
+
+func flattenTasks(apiObjects []*appflow.Task) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination_field": aws.StringValue(apiObject.DestinationField),
+			"source_fields":     aws.StringValueSlice(apiObject.SourceFields),
+			"task_properties":   aws.StringValueMap(apiObject.TaskProperties),
+			"task_type":         aws.StringValue(apiObject.TaskType),
+		}
+
+		if v := apiObject.ConnectorOperator; v != nil {
+			tfMap["connector_operator"] = []interface{}{map[string]interface{}{
+				"amplitude":        aws.StringValue(v.Amplitude),
+				"datadog":          aws.StringValue(v.Datadog),
+				"google_analytics": aws.StringValue(v.GoogleAnalytics),
+				"marketo":          aws.StringValue(v.Marketo),
+				"s3":               aws.StringValue(v.S3),
+				"salesforce":       aws.StringValue(v.Salesforce),
+				"service_now":      aws.StringValue(v.ServiceNow),
+				"slack":            aws.StringValue(v.Slack),
+				"zendesk":          aws.StringValue(v.Zendesk),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTriggerConfig(apiObject *appflow.TriggerConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"trigger_type": aws.StringValue(apiObject.TriggerType),
+	}
+
+	if v := apiObject.TriggerProperties; v != nil && v.Scheduled != nil {
+		tfMap["trigger_properties"] = []interface{}{map[string]interface{}{
+			"scheduled": flattenScheduledTriggerProperties(v.Scheduled),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScheduledTriggerProperties(apiObject *appflow.ScheduledTriggerProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"data_pull_mode":      aws.StringValue(apiObject.DataPullMode),
+		"schedule_expression": aws.StringValue(apiObject.ScheduleExpression),
+		"schedule_offset":     aws.Int64Value(apiObject.ScheduleOffset),
+		"timezone":            aws.StringValue(apiObject.Timezone),
+	}
+
+	if v := apiObject.FirstExecutionFrom; v != nil {
+		tfMap["first_execution_from"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ScheduleEndTime; v != nil {
+		tfMap["schedule_end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ScheduleStartTime; v != nil {
+		tfMap["schedule_start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,474 @@
+package appflow_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appflow"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppFlowFlow_basic(t *testing.T) {
+	var flow appflow.DescribeFlowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appflow", regexp.MustCompile(`flow/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.connector_type", appflow.ConnectorTypeS3),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.bucket_name", "aws_s3_bucket.destination", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "flow_status", appflow.FlowStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.connector_type", appflow.ConnectorTypeS3),
+					resource.TestCheckResourceAttrPair(resourceName, "source_flow_config.0.source_connector_properties.0.s3.0.bucket_name", "aws_s3_bucket.source", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.source_connector_properties.0.s3.0.bucket_prefix", "flow"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task.0.task_type", appflow.TaskTypeMap),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeOnDemand),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_disappears(t *testing.T) {
+	var flow appflow.DescribeFlowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappflow.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_scheduled(t *testing.T) {
+	var flow appflow.DescribeFlowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowScheduledConfig(rName, appflow.FlowStatusActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "flow_status", appflow.FlowStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.data_pull_mode", appflow.DataPullModeIncremental),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.schedule_expression", "rate(1hours)"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowScheduledConfig(rName, appflow.FlowStatusSuspended),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "flow_status", appflow.FlowStatusSuspended),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_tags(t *testing.T) {
+	var flow appflow.DescribeFlowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowExists(n string, v *appflow.DescribeFlowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppFlow Flow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+		output, err := tfappflow.FindFlowByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appflow_flow" {
+			continue
+		}
+
+		_, err := tfappflow.FindFlowByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppFlow Flow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFlowBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "source" {
+  bucket = aws_s3_bucket.source.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowAppFlowSourceActions"
+      Effect = "Allow"
+      Principal = {
+        Service = "appflow.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = [
+        "s3:ListBucket",
+        "s3:GetObject",
+      ]
+      Resource = [
+        aws_s3_bucket.source.arn,
+        "${aws_s3_bucket.source.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = aws_s3_bucket_policy.source.bucket
+  key     = "flow/data.csv"
+  content = "id,name\n1,test\n"
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket        = "%[1]s-destination"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "destination" {
+  bucket = aws_s3_bucket.destination.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowAppFlowDestinationActions"
+      Effect = "Allow"
+      Principal = {
+        Service = "appflow.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = [
+        "s3:PutObject",
+        "s3:AbortMultipartUpload",
+        "s3:ListMultipartUploadParts",
+        "s3:ListBucketMultipartUploads",
+        "s3:GetBucketAcl",
+        "s3:PutObjectAcl",
+      ]
+      Resource = [
+        aws_s3_bucket.destination.arn,
+        "${aws_s3_bucket.destination.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccFlowConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFlowBaseConfig(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_object.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["id"]
+    destination_field = "id"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+}
+`, rName))
+}
+
+func testAccFlowScheduledConfig(rName, flowStatus string) string {
+	return acctest.ConfigCompose(testAccFlowBaseConfig(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name        = %[1]q
+  flow_status = %[2]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_object.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["id"]
+    destination_field = "id"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "Scheduled"
+
+    trigger_properties {
+      scheduled {
+        data_pull_mode      = "Incremental"
+        schedule_expression = "rate(1hours)"
+      }
+    }
+  }
+}
+`, rName, flowStatus))
+}
+
+func testAccFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFlowBaseConfig(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_object.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["id"]
+    destination_field = "id"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFlowBaseConfig(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_object.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["id"]
+    destination_field = "id"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
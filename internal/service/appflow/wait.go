@@ -0,0 +1,54 @@
+package appflow
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	flowActivatedTimeout = 5 * time.Minute
+	flowSuspendedTimeout = 5 * time.Minute
+)
+
+func waitFlowActivated(conn *appflow.Appflow, name string) (*appflow.DescribeFlowOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appflow.FlowStatusDraft, appflow.FlowStatusSuspended},
+		Target:  []string{appflow.FlowStatusActive},
+		Refresh: statusFlow(conn, name),
+		Timeout: flowActivatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appflow.DescribeFlowOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FlowStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowSuspended(conn *appflow.Appflow, name string) (*appflow.DescribeFlowOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appflow.FlowStatusActive},
+		Target:  []string{appflow.FlowStatusSuspended},
+		Refresh: statusFlow(conn, name),
+		Timeout: flowSuspendedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appflow.DescribeFlowOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FlowStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
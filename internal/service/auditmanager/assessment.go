@@ -0,0 +1,483 @@
+package auditmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssessment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAssessmentCreate,
+		Read:   resourceAssessmentRead,
+		Update: resourceAssessmentUpdate,
+		Delete: resourceAssessmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_reports_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"destination_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.AssessmentReportDestinationType_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"framework_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     assessmentRoleSchema(),
+			},
+			"roles_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     assessmentRoleSchema(),
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"aws_services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func assessmentRoleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAssessmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentInput{
+		FrameworkId: aws.String(d.Get("framework_id").(string)),
+		Name:        aws.String(name),
+		Roles:       expandAssessmentRoles(d.Get("roles").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("assessment_reports_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AssessmentReportsDestination = expandAssessmentReportsDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Scope = expandAssessmentScope(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		input.Scope = &auditmanager.Scope{}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Assessment: %s", input)
+	output, err := conn.CreateAssessment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Audit Manager Assessment (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assessment.Metadata.Id))
+
+	return resourceAssessmentRead(d, meta)
+}
+
+func resourceAssessmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	assessment, err := FindAssessmentByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Assessment (%s): %w", d.Id(), err)
+	}
+
+	metadata := assessment.Metadata
+
+	d.Set("arn", assessment.Arn)
+	if metadata.AssessmentReportsDestination != nil {
+		if err := d.Set("assessment_reports_destination", []interface{}{flattenAssessmentReportsDestination(metadata.AssessmentReportsDestination)}); err != nil {
+			return fmt.Errorf("error setting assessment_reports_destination: %w", err)
+		}
+	} else {
+		d.Set("assessment_reports_destination", nil)
+	}
+	d.Set("description", metadata.Description)
+	if assessment.Framework != nil {
+		d.Set("framework_id", assessment.Framework.Id)
+	}
+	d.Set("name", metadata.Name)
+	// Audit Manager automatically grants access to additional roles (e.g. the role of the
+	// caller), so only the configured roles are tracked in "roles".
+	if err := d.Set("roles", flattenConfiguredAssessmentRoles(metadata.Roles, d.Get("roles").(*schema.Set))); err != nil {
+		return fmt.Errorf("error setting roles: %w", err)
+	}
+	if err := d.Set("roles_all", flattenAssessmentRoles(metadata.Roles)); err != nil {
+		return fmt.Errorf("error setting roles_all: %w", err)
+	}
+	if metadata.Scope != nil {
+		if err := d.Set("scope", []interface{}{flattenAssessmentScope(metadata.Scope)}); err != nil {
+			return fmt.Errorf("error setting scope: %w", err)
+		}
+	} else {
+		d.Set("scope", nil)
+	}
+	d.Set("status", metadata.Status)
+
+	tags := KeyValueTags(assessment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAssessmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateAssessmentInput{
+			AssessmentId:   aws.String(d.Id()),
+			AssessmentName: aws.String(d.Get("name").(string)),
+			Roles:          expandAssessmentRoles(d.Get("roles").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("assessment_reports_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AssessmentReportsDestination = expandAssessmentReportsDestination(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.AssessmentDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Scope = expandAssessmentScope(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Scope = &auditmanager.Scope{}
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Assessment: %s", input)
+		_, err := conn.UpdateAssessment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Audit Manager Assessment (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Audit Manager Assessment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAssessmentRead(d, meta)
+}
+
+func resourceAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment: %s", d.Id())
+	_, err := conn.DeleteAssessment(&auditmanager.DeleteAssessmentInput{
+		AssessmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Audit Manager Assessment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAssessmentReportsDestination(tfMap map[string]interface{}) *auditmanager.AssessmentReportsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.AssessmentReportsDestination{}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_type"].(string); ok && v != "" {
+		apiObject.DestinationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAssessmentRoles(tfList []interface{}) []*auditmanager.Role {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.Role
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.Role{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["role_type"].(string); ok && v != "" {
+			apiObject.RoleType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssessmentScope(tfMap map[string]interface{}) *auditmanager.Scope {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.Scope{}
+
+	if v, ok := tfMap["aws_accounts"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfAccountRaw := range v.List() {
+			tfAccount, ok := tfAccountRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AwsAccounts = append(apiObject.AwsAccounts, &auditmanager.AWSAccount{
+				Id: aws.String(tfAccount["id"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["aws_services"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfServiceRaw := range v.List() {
+			tfService, ok := tfServiceRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AwsServices = append(apiObject.AwsServices, &auditmanager.AWSService{
+				ServiceName: aws.String(tfService["service_name"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAssessmentReportsDestination(apiObject *auditmanager.AssessmentReportsDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination":      aws.StringValue(apiObject.Destination),
+		"destination_type": aws.StringValue(apiObject.DestinationType),
+	}
+
+	return tfMap
+}
+
+func flattenAssessmentRoles(apiObjects []*auditmanager.Role) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"role_arn":  aws.StringValue(apiObject.RoleArn),
+			"role_type": aws.StringValue(apiObject.RoleType),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenConfiguredAssessmentRoles returns the subset of roles that are present in the configured set.
+// All roles are returned if no roles are configured, e.g. on import.
+func flattenConfiguredAssessmentRoles(apiObjects []*auditmanager.Role, configured *schema.Set) []interface{} {
+	tfList := flattenAssessmentRoles(apiObjects)
+
+	if configured.Len() == 0 {
+		return tfList
+	}
+
+	var result []interface{}
+
+	for _, tfMap := range tfList {
+		if configured.Contains(tfMap) {
+			result = append(result, tfMap)
+		}
+	}
+
+	return result
+}
+
+func flattenAssessmentScope(apiObject *auditmanager.Scope) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var accounts []interface{}
+
+	for _, account := range apiObject.AwsAccounts {
+		if account == nil {
+			continue
+		}
+
+		accounts = append(accounts, map[string]interface{}{
+			"id": aws.StringValue(account.Id),
+		})
+	}
+
+	var services []interface{}
+
+	for _, service := range apiObject.AwsServices {
+		if service == nil {
+			continue
+		}
+
+		services = append(services, map[string]interface{}{
+			"service_name": aws.StringValue(service.ServiceName),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_accounts": accounts,
+		"aws_services": services,
+	}
+
+	return tfMap
+}
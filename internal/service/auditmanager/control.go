@@ -0,0 +1,442 @@
+package auditmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceControlCreate,
+		Read:   resourceControlRead,
+		Update: resourceControlUpdate,
+		Delete: resourceControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action_plan_instructions": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action_plan_title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_mapping_sources": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_frequency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceFrequency_Values(), false),
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_keyword": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keyword_input_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(auditmanager.KeywordInputType_Values(), false),
+									},
+									"keyword_value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"source_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"source_set_up_option": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceSetUpOption_Values(), false),
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceType_Values(), false),
+						},
+						"troubleshooting_text": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"testing_information": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceControlCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateControlInput{
+		ControlMappingSources: expandCreateControlMappingSources(d.Get("control_mapping_sources").([]interface{})),
+		Name:                  aws.String(name),
+	}
+
+	if v, ok := d.GetOk("action_plan_instructions"); ok {
+		input.ActionPlanInstructions = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("action_plan_title"); ok {
+		input.ActionPlanTitle = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("testing_information"); ok {
+		input.TestingInformation = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Control: %s", input)
+	output, err := conn.CreateControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Audit Manager Control (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Control.Id))
+
+	return resourceControlRead(d, meta)
+}
+
+func resourceControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	control, err := FindControlByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Control (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action_plan_instructions", control.ActionPlanInstructions)
+	d.Set("action_plan_title", control.ActionPlanTitle)
+	d.Set("arn", control.Arn)
+	if err := d.Set("control_mapping_sources", flattenControlMappingSources(control.ControlMappingSources)); err != nil {
+		return fmt.Errorf("error setting control_mapping_sources: %w", err)
+	}
+	d.Set("description", control.Description)
+	d.Set("name", control.Name)
+	d.Set("testing_information", control.TestingInformation)
+	d.Set("type", control.Type)
+
+	tags := KeyValueTags(control.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateControlInput{
+			ControlId:             aws.String(d.Id()),
+			ControlMappingSources: expandControlMappingSources(d.Get("control_mapping_sources").([]interface{})),
+			Name:                  aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("action_plan_instructions"); ok {
+			input.ActionPlanInstructions = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("action_plan_title"); ok {
+			input.ActionPlanTitle = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("testing_information"); ok {
+			input.TestingInformation = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Control: %s", input)
+		_, err := conn.UpdateControl(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Audit Manager Control (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Audit Manager Control (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceControlRead(d, meta)
+}
+
+func resourceControlDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Control: %s", d.Id())
+	_, err := conn.DeleteControl(&auditmanager.DeleteControlInput{
+		ControlId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Audit Manager Control (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCreateControlMappingSources(tfList []interface{}) []*auditmanager.CreateControlMappingSource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.CreateControlMappingSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateControlMappingSource{}
+
+		if v, ok := tfMap["source_description"].(string); ok && v != "" {
+			apiObject.SourceDescription = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_frequency"].(string); ok && v != "" {
+			apiObject.SourceFrequency = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_keyword"].([]interface{}); ok && len(v) > 0 {
+			apiObject.SourceKeyword = expandSourceKeyword(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_name"].(string); ok && v != "" {
+			apiObject.SourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_set_up_option"].(string); ok && v != "" {
+			apiObject.SourceSetUpOption = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_type"].(string); ok && v != "" {
+			apiObject.SourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["troubleshooting_text"].(string); ok && v != "" {
+			apiObject.TroubleshootingText = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandControlMappingSources(tfList []interface{}) []*auditmanager.ControlMappingSource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.ControlMappingSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.ControlMappingSource{}
+
+		if v, ok := tfMap["source_description"].(string); ok && v != "" {
+			apiObject.SourceDescription = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_frequency"].(string); ok && v != "" {
+			apiObject.SourceFrequency = aws.String(v)
+		}
+
+		// Existing mapping sources are identified by their ID, new ones are created.
+		if v, ok := tfMap["source_id"].(string); ok && v != "" {
+			apiObject.SourceId = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_keyword"].([]interface{}); ok && len(v) > 0 {
+			apiObject.SourceKeyword = expandSourceKeyword(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_name"].(string); ok && v != "" {
+			apiObject.SourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_set_up_option"].(string); ok && v != "" {
+			apiObject.SourceSetUpOption = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_type"].(string); ok && v != "" {
+			apiObject.SourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["troubleshooting_text"].(string); ok && v != "" {
+			apiObject.TroubleshootingText = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSourceKeyword(tfMap map[string]interface{}) *auditmanager.SourceKeyword {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.SourceKeyword{}
+
+	if v, ok := tfMap["keyword_input_type"].(string); ok && v != "" {
+		apiObject.KeywordInputType = aws.String(v)
+	}
+
+	if v, ok := tfMap["keyword_value"].(string); ok && v != "" {
+		apiObject.KeywordValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenControlMappingSources(apiObjects []*auditmanager.ControlMappingSource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"source_description":   aws.StringValue(apiObject.SourceDescription),
+			"source_frequency":     aws.StringValue(apiObject.SourceFrequency),
+			"source_id":            aws.StringValue(apiObject.SourceId),
+			"source_name":          aws.StringValue(apiObject.SourceName),
+			"source_set_up_option": aws.StringValue(apiObject.SourceSetUpOption),
+			"source_type":          aws.StringValue(apiObject.SourceType),
+			"troubleshooting_text": aws.StringValue(apiObject.TroubleshootingText),
+		}
+
+		if v := apiObject.SourceKeyword; v != nil {
+			tfMap["source_keyword"] = []interface{}{flattenSourceKeyword(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSourceKeyword(apiObject *auditmanager.SourceKeyword) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"keyword_input_type": aws.StringValue(apiObject.KeywordInputType),
+		"keyword_value":      aws.StringValue(apiObject.KeywordValue),
+	}
+
+	return tfMap
+}
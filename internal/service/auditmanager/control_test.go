@@ -0,0 +1,328 @@
+package auditmanager_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAuditManagerControl_basic(t *testing.T) {
+	var control auditmanager.Control
+	resourceName := "aws_auditmanager_control.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "auditmanager", regexp.MustCompile(`control/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.0.source_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.0.source_set_up_option", auditmanager.SourceSetUpOptionProceduralControlsMapping),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.0.source_type", auditmanager.SourceTypeManual),
+					resource.TestCheckResourceAttrSet(resourceName, "control_mapping_sources.0.source_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Custom"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControl_disappears(t *testing.T) {
+	var control auditmanager.Control
+	resourceName := "aws_auditmanager_control.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControl_tags(t *testing.T) {
+	var control auditmanager.Control
+	resourceName := "aws_auditmanager_control.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccControlConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccControlConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControl_update(t *testing.T) {
+	var control auditmanager.Control
+	resourceName := "aws_auditmanager_control.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config: testAccControlUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "action_plan_instructions", "instructions"),
+					resource.TestCheckResourceAttr(resourceName, "action_plan_title", "title"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_frequency", auditmanager.SourceFrequencyDaily),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_keyword.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_keyword.0.keyword_input_type", auditmanager.KeywordInputTypeSelectFromList),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_keyword.0.keyword_value", "s3_bucket_acl_prohibited"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_type", auditmanager.SourceTypeAwsConfig),
+					resource.TestCheckResourceAttr(resourceName, "description", "described"),
+					resource.TestCheckResourceAttr(resourceName, "testing_information", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	output, err := conn.GetAccountStatus(&auditmanager.GetAccountStatusInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if status := aws.StringValue(output.Status); status != auditmanager.AccountStatusActive {
+		t.Skipf("skipping acceptance testing: Audit Manager account status is %s, expected %s", status, auditmanager.AccountStatusActive)
+	}
+}
+
+func testAccCheckControlExists(n string, v *auditmanager.Control) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Control ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		output, err := tfauditmanager.FindControlByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckControlDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_control" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindControlByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccControlConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+}
+`, rName)
+}
+
+func testAccControlUpdatedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name                     = %[1]q
+  action_plan_instructions = "instructions"
+  action_plan_title        = "title"
+  description              = "described"
+  testing_information      = "test"
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+
+  control_mapping_sources {
+    source_name          = "%[1]s-config"
+    source_frequency     = "DAILY"
+    source_set_up_option = "System_Controls_Mapping"
+    source_type          = "AWS_Config"
+
+    source_keyword {
+      keyword_input_type = "SELECT_FROM_LIST"
+      keyword_value      = "s3_bucket_acl_prohibited"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccControlConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccControlConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,122 @@
+package auditmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAssessmentByID(conn *auditmanager.AuditManager, id string) (*auditmanager.Assessment, error) {
+	input := &auditmanager.GetAssessmentInput{
+		AssessmentId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessment(input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil || output.Assessment.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func FindControlByID(conn *auditmanager.AuditManager, id string) (*auditmanager.Control, error) {
+	input := &auditmanager.GetControlInput{
+		ControlId: aws.String(id),
+	}
+
+	output, err := conn.GetControl(input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Control == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Control, nil
+}
+
+func FindFrameworkByID(conn *auditmanager.AuditManager, id string) (*auditmanager.Framework, error) {
+	input := &auditmanager.GetAssessmentFrameworkInput{
+		FrameworkId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentFramework(input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Framework == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Framework, nil
+}
+
+func FindFrameworkMetadataByNameAndType(conn *auditmanager.AuditManager, name, frameworkType string) (*auditmanager.AssessmentFrameworkMetadata, error) {
+	input := &auditmanager.ListAssessmentFrameworksInput{
+		FrameworkType: aws.String(frameworkType),
+	}
+	var result *auditmanager.AssessmentFrameworkMetadata
+
+	err := conn.ListAssessmentFrameworksPages(input, func(page *auditmanager.ListAssessmentFrameworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, framework := range page.FrameworkMetadataList {
+			if framework == nil {
+				continue
+			}
+
+			if aws.StringValue(framework.Name) == name {
+				result = framework
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,358 @@
+package auditmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFramework() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFrameworkCreate,
+		Read:   resourceFrameworkRead,
+		Update: resourceFrameworkUpdate,
+		Delete: resourceFrameworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"control_sets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controls": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 300),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"framework_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFrameworkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentFrameworkInput{
+		ControlSets: expandCreateAssessmentFrameworkControlSets(d.Get("control_sets").([]interface{})),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("compliance_type"); ok {
+		input.ComplianceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Framework: %s", input)
+	output, err := conn.CreateAssessmentFramework(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Audit Manager Framework (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Framework.Id))
+
+	return resourceFrameworkRead(d, meta)
+}
+
+func resourceFrameworkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	framework, err := FindFrameworkByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Framework (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Framework (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", framework.Arn)
+	d.Set("compliance_type", framework.ComplianceType)
+	if err := d.Set("control_sets", flattenFrameworkControlSets(framework.ControlSets)); err != nil {
+		return fmt.Errorf("error setting control_sets: %w", err)
+	}
+	d.Set("description", framework.Description)
+	d.Set("framework_type", framework.Type)
+	d.Set("name", framework.Name)
+
+	tags := KeyValueTags(framework.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFrameworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateAssessmentFrameworkInput{
+			ControlSets: expandUpdateAssessmentFrameworkControlSets(d.Get("control_sets").([]interface{})),
+			FrameworkId: aws.String(d.Id()),
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("compliance_type"); ok {
+			input.ComplianceType = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Framework: %s", input)
+		_, err := conn.UpdateAssessmentFramework(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Audit Manager Framework (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Audit Manager Framework (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFrameworkRead(d, meta)
+}
+
+func resourceFrameworkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Framework: %s", d.Id())
+	_, err := conn.DeleteAssessmentFramework(&auditmanager.DeleteAssessmentFrameworkInput{
+		FrameworkId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Audit Manager Framework (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCreateAssessmentFrameworkControlSets(tfList []interface{}) []*auditmanager.CreateAssessmentFrameworkControlSet {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.CreateAssessmentFrameworkControlSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateAssessmentFrameworkControlSet{}
+
+		if v, ok := tfMap["controls"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Controls = expandCreateAssessmentFrameworkControls(v.List())
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandUpdateAssessmentFrameworkControlSets(tfList []interface{}) []*auditmanager.UpdateAssessmentFrameworkControlSet {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.UpdateAssessmentFrameworkControlSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.UpdateAssessmentFrameworkControlSet{}
+
+		if v, ok := tfMap["controls"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Controls = expandCreateAssessmentFrameworkControls(v.List())
+		}
+
+		// Existing control sets are identified by their ID, new ones are created.
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCreateAssessmentFrameworkControls(tfList []interface{}) []*auditmanager.CreateAssessmentFrameworkControl {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.CreateAssessmentFrameworkControl
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateAssessmentFrameworkControl{}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFrameworkControlSets(apiObjects []*auditmanager.ControlSet) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"controls": flattenFrameworkControls(apiObject.Controls),
+			"id":       aws.StringValue(apiObject.Id),
+			"name":     aws.StringValue(apiObject.Name),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFrameworkControls(apiObjects []*auditmanager.Control) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id": aws.StringValue(apiObject.Id),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
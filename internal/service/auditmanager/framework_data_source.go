@@ -0,0 +1,107 @@
+package auditmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFramework() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFrameworkRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controls": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"framework_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.FrameworkType_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceFrameworkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	frameworkType := d.Get("framework_type").(string)
+	metadata, err := FindFrameworkMetadataByNameAndType(conn, name, frameworkType)
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager %s Framework (%s): %w", frameworkType, name, err)
+	}
+
+	id := aws.StringValue(metadata.Id)
+	framework, err := FindFrameworkByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Framework (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("arn", framework.Arn)
+	d.Set("compliance_type", framework.ComplianceType)
+	if err := d.Set("control_sets", flattenFrameworkControlSets(framework.ControlSets)); err != nil {
+		return fmt.Errorf("error setting control_sets: %w", err)
+	}
+	d.Set("description", framework.Description)
+	d.Set("framework_type", framework.Type)
+	d.Set("name", framework.Name)
+
+	if err := d.Set("tags", KeyValueTags(framework.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAuditManagerFrameworkDataSource_standard(t *testing.T) {
+	dataSourceName := "data.aws_auditmanager_framework.test"
+	name := "Essential Eight"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkDataSourceConfig(name, auditmanager.FrameworkTypeStandard),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "framework_type", auditmanager.FrameworkTypeStandard),
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "control_sets.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerFrameworkDataSource_custom(t *testing.T) {
+	dataSourceName := "data.aws_auditmanager_framework.test"
+	resourceName := "aws_auditmanager_framework.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkDataSourceCustomConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_sets.#", resourceName, "control_sets.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_sets.0.id", resourceName, "control_sets.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFrameworkDataSourceConfig(name, frameworkType string) string {
+	return fmt.Sprintf(`
+data "aws_auditmanager_framework" "test" {
+  name           = %[1]q
+  framework_type = %[2]q
+}
+`, name, frameworkType)
+}
+
+func testAccFrameworkDataSourceCustomConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFrameworkConfig(rName), `
+data "aws_auditmanager_framework" "test" {
+  name           = aws_auditmanager_framework.test.name
+  framework_type = "Custom"
+}
+`)
+}
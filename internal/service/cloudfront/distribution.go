@@ -577,6 +577,10 @@ func ResourceDistribution() *schema.Resource {
 								},
 							},
 						},
+						"origin_access_control_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"origin_id": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -717,6 +717,31 @@ func TestCloudFrontStructure_flattenOrigin(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_flattenOrigin_originAccessControl(t *testing.T) {
+	in := map[string]interface{}{
+		"origin_id":                "S3Origin",
+		"domain_name":              "s3.example.com",
+		"origin_access_control_id": "E2QWRUHAPOMQZL",
+	}
+	or := tfcloudfront.ExpandOrigin(in)
+
+	if *or.OriginAccessControlId != "E2QWRUHAPOMQZL" {
+		t.Fatalf("Expected OriginAccessControlId to be E2QWRUHAPOMQZL, got %v", *or.OriginAccessControlId)
+	}
+	if or.S3OriginConfig == nil || *or.S3OriginConfig.OriginAccessIdentity != "" {
+		t.Fatalf("Expected S3OriginConfig to have an empty OriginAccessIdentity, got %v", or.S3OriginConfig)
+	}
+
+	out := tfcloudfront.FlattenOrigin(or)
+
+	if out["origin_access_control_id"] != "E2QWRUHAPOMQZL" {
+		t.Fatalf("Expected out[origin_access_control_id] to be E2QWRUHAPOMQZL, got %v", out["origin_access_control_id"])
+	}
+	if _, ok := out["s3_origin_config"]; ok {
+		t.Fatalf("Expected out[s3_origin_config] to be absent, got %v", out["s3_origin_config"])
+	}
+}
+
 func TestCloudFrontStructure_expandCustomHeaders(t *testing.T) {
 	in := originCustomHeadersConf()
 	chs := tfcloudfront.ExpandCustomHeaders(in)
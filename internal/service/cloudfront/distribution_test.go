@@ -522,6 +522,32 @@ func TestAccCloudFrontDistribution_Origin_originShield(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistribution_Origin_originAccessControl(t *testing.T) {
+	var distribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rInt := sdkacctest.RandInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService("cloudfront", t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccDistributionOriginItem(rName, rInt, `origin_access_control_id = aws_cloudfront_origin_access_control.test.id`),
+					testAccOriginAccessControlConfig(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExists(resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "origin.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origin.*.origin_access_control_id", "aws_cloudfront_origin_access_control.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccCloudFrontDistribution_noOptionalItems runs an
 // aws_cloudfront_distribution acceptance test with no optional items set.
 //
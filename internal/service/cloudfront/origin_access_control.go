@@ -0,0 +1,158 @@
+package cloudfront
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceOriginAccessControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOriginAccessControlCreate,
+		Read:   resourceOriginAccessControlRead,
+		Update: resourceOriginAccessControlUpdate,
+		Delete: resourceOriginAccessControlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			// Only Amazon S3 origins are supported by this version of the CloudFront API.
+			// Lambda function URL origins cannot yet be signed via an origin access control.
+			"origin_access_control_origin_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlOriginTypes_Values(), false),
+			},
+			"signing_behavior": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlSigningBehaviors_Values(), false),
+			},
+			"signing_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlSigningProtocols_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceOriginAccessControlCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateOriginAccessControlInput{
+		OriginAccessControlConfig: &cloudfront.OriginAccessControlConfig{
+			Description:                   aws.String(d.Get("description").(string)),
+			Name:                          aws.String(name),
+			OriginAccessControlOriginType: aws.String(d.Get("origin_access_control_origin_type").(string)),
+			SigningBehavior:               aws.String(d.Get("signing_behavior").(string)),
+			SigningProtocol:               aws.String(d.Get("signing_protocol").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Origin Access Control: (%s)", input)
+	output, err := conn.CreateOriginAccessControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Origin Access Control (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OriginAccessControl.Id))
+
+	return resourceOriginAccessControlRead(d, meta)
+}
+
+func resourceOriginAccessControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	output, err := FindOriginAccessControlByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Origin Access Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	apiObject := output.OriginAccessControl.OriginAccessControlConfig
+	d.Set("description", apiObject.Description)
+	d.Set("etag", output.ETag)
+	d.Set("name", apiObject.Name)
+	d.Set("origin_access_control_origin_type", apiObject.OriginAccessControlOriginType)
+	d.Set("signing_behavior", apiObject.SigningBehavior)
+	d.Set("signing_protocol", apiObject.SigningProtocol)
+
+	return nil
+}
+
+func resourceOriginAccessControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	input := &cloudfront.UpdateOriginAccessControlInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+		OriginAccessControlConfig: &cloudfront.OriginAccessControlConfig{
+			Description:                   aws.String(d.Get("description").(string)),
+			Name:                          aws.String(d.Get("name").(string)),
+			OriginAccessControlOriginType: aws.String(d.Get("origin_access_control_origin_type").(string)),
+			SigningBehavior:               aws.String(d.Get("signing_behavior").(string)),
+			SigningProtocol:               aws.String(d.Get("signing_protocol").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Origin Access Control: (%s)", input)
+	_, err := conn.UpdateOriginAccessControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	return resourceOriginAccessControlRead(d, meta)
+}
+
+func resourceOriginAccessControlDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	log.Printf("[DEBUG] Deleting CloudFront Origin Access Control: (%s)", d.Id())
+	_, err := conn.DeleteOriginAccessControl(&cloudfront.DeleteOriginAccessControlInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginAccessControl) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
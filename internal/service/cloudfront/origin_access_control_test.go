@@ -0,0 +1,178 @@
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudFrontOriginAccessControl_basic(t *testing.T) {
+	var originAccessControl cloudfront.GetOriginAccessControlOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &originAccessControl),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_control_origin_type", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "always"),
+					resource.TestCheckResourceAttr(resourceName, "signing_protocol", "sigv4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontOriginAccessControl_disappears(t *testing.T) {
+	var originAccessControl cloudfront.GetOriginAccessControlOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &originAccessControl),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudfront.ResourceOriginAccessControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontOriginAccessControl_update(t *testing.T) {
+	var originAccessControl cloudfront.GetOriginAccessControlOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlSigningConfig(rName, "test description", "never"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &originAccessControl),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "never"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginAccessControlSigningConfig(rName, "test description updated", "no-override"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &originAccessControl),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description updated"),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "no-override"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontOriginAccessControlDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_origin_access_control" {
+			continue
+		}
+
+		_, err := tfcloudfront.FindOriginAccessControlByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Origin Access Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontOriginAccessControlExists(n string, v *cloudfront.GetOriginAccessControlOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Origin Access Control ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindOriginAccessControlByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOriginAccessControlConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = "always"
+  signing_protocol                  = "sigv4"
+}
+`, rName)
+}
+
+func testAccOriginAccessControlSigningConfig(rName, description, signingBehavior string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  description                       = %[2]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = %[3]q
+  signing_protocol                  = "sigv4"
+}
+`, rName, description, signingBehavior)
+}
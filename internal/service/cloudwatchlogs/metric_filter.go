@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -80,7 +81,7 @@ func ResourceMetricFilter() *schema.Resource {
 						"default_value": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidTypeStringNullableFloat,
+							ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 						},
 						"dimensions": {
 							Type:     schema.TypeMap,
@@ -0,0 +1,233 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBotAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBotAssociationCreate,
+		ReadContext:   resourceBotAssociationRead,
+		DeleteContext: resourceBotAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"lex_bot": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lex_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(2, 50),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Get("instance_id").(string)
+	lexBot := expandBotAssociationLexBot(d.Get("lex_bot").([]interface{}))
+
+	// The Lex region defaults to the region of the Connect instance.
+	if aws.StringValue(lexBot.LexRegion) == "" {
+		lexBot.LexRegion = aws.String(meta.(*conns.AWSClient).Region)
+	}
+
+	input := &connect.AssociateBotInput{
+		InstanceId: aws.String(instanceID),
+		LexBot:     lexBot,
+	}
+
+	log.Printf("[DEBUG] Creating Connect Bot Association %s", input)
+	_, err := conn.AssociateBotWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Bot Association (%s,%s): %w", instanceID, aws.StringValue(lexBot.Name), err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", instanceID, aws.StringValue(lexBot.Name), aws.StringValue(lexBot.LexRegion)))
+
+	return resourceBotAssociationRead(ctx, d, meta)
+}
+
+func resourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, name, region, err := BotAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lexBot, err := FindBotAssociationV1ByNameAndRegion(ctx, conn, instanceID, name, region)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Bot Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Bot Association (%s): %w", d.Id(), err))
+	}
+
+	d.Set("instance_id", instanceID)
+
+	if err := d.Set("lex_bot", flattenBotAssociationLexBot(lexBot)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lex_bot: %w", err))
+	}
+
+	return nil
+}
+
+func resourceBotAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, name, region, err := BotAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DisassociateBotWithContext(ctx, &connect.DisassociateBotInput{
+		InstanceId: aws.String(instanceID),
+		LexBot: &connect.LexBot{
+			LexRegion: aws.String(region),
+			Name:      aws.String(name),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Bot Association (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// FindBotAssociationV1ByNameAndRegion returns the Amazon Lex (V1) bot associated with the Connect instance.
+func FindBotAssociationV1ByNameAndRegion(ctx context.Context, conn *connect.Connect, instanceID, name, region string) (*connect.LexBot, error) {
+	var result *connect.LexBot
+
+	input := &connect.ListBotsInput{
+		InstanceId: aws.String(instanceID),
+		LexVersion: aws.String(connect.LexVersionV1),
+		MaxResults: aws.Int64(ListBotsMaxResults),
+	}
+
+	err := conn.ListBotsPagesWithContext(ctx, input, func(page *connect.ListBotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LexBots {
+			if v == nil || v.LexBot == nil {
+				continue
+			}
+
+			if aws.StringValue(v.LexBot.Name) == name && aws.StringValue(v.LexBot.LexRegion) == region {
+				result = v.LexBot
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func expandBotAssociationLexBot(tfList []interface{}) *connect.LexBot {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connect.LexBot{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["lex_region"].(string); ok && v != "" {
+		apiObject.LexRegion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBotAssociationLexBot(apiObject *connect.LexBot) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"lex_region": aws.StringValue(apiObject.LexRegion),
+		"name":       aws.StringValue(apiObject.Name),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func BotAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:name:region", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,49 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConnectBotAssociationDataSource_lexBot(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	resourceName := "aws_connect_bot_association.test"
+	datasourceName := "data.aws_connect_bot_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAssociationDataSourceConfig_LexBot(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "lex_bot.#", resourceName, "lex_bot.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "lex_bot.0.lex_region", resourceName, "lex_bot.0.lex_region"),
+					resource.TestCheckResourceAttrPair(datasourceName, "lex_bot.0.name", resourceName, "lex_bot.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBotAssociationDataSourceConfig_LexBot(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccBotAssociationBasicConfig(rName, rName2),
+		`
+data "aws_connect_bot_association" "test" {
+  instance_id = aws_connect_bot_association.test.instance_id
+
+  lex_bot {
+    name = aws_connect_bot_association.test.lex_bot[0].name
+  }
+}
+`)
+}
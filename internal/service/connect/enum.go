@@ -8,6 +8,30 @@ const (
 	ListInstancesMaxResults = 10
 	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
 	ListContactFlowsMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 25
+	ListBotsMaxResults = 25
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListHoursOfOperationsMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 25
+	ListLambdaFunctionsMaxResults = 25
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListPromptsMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 100
+	ListQueueQuickConnectsMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListQueuesMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListQuickConnectsMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 100
+	ListRoutingProfileQueuesMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListRoutingProfilesMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListSecurityProfilePermissionsMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListSecurityProfilesMaxResults = 60
+	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
+	ListUsersMaxResults = 60
 )
 
 func InstanceAttributeMapping() map[string]string {
@@ -0,0 +1,353 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceHoursOfOperation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHoursOfOperationCreate,
+		ReadContext:   resourceHoursOfOperationRead,
+		UpdateContext: resourceHoursOfOperationUpdate,
+		DeleteContext: resourceHoursOfOperationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.HoursOfOperationDays_Values(), false),
+						},
+						"end_time": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hours": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 23),
+									},
+									"minutes": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 59),
+									},
+								},
+							},
+						},
+						"start_time": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hours": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 23),
+									},
+									"minutes": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 59),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"hours_of_operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"time_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceHoursOfOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateHoursOfOperationInput{
+		Config:     expandHoursOfOperationConfigs(d.Get("config").(*schema.Set).List()),
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		TimeZone:   aws.String(d.Get("time_zone").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Connect Hours of Operation %s", input)
+	output, err := conn.CreateHoursOfOperationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Hours of Operation (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Hours of Operation (%s): empty output", name))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.HoursOfOperationId)))
+
+	return resourceHoursOfOperationRead(ctx, d, meta)
+}
+
+func resourceHoursOfOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID, hoursOfOperationID, err := HoursOfOperationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := conn.DescribeHoursOfOperationWithContext(ctx, &connect.DescribeHoursOfOperationInput{
+		HoursOfOperationId: aws.String(hoursOfOperationID),
+		InstanceId:         aws.String(instanceID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Hours of Operation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): %w", d.Id(), err))
+	}
+
+	if resp == nil || resp.HoursOfOperation == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): empty response", d.Id()))
+	}
+
+	d.Set("arn", resp.HoursOfOperation.HoursOfOperationArn)
+	d.Set("hours_of_operation_id", resp.HoursOfOperation.HoursOfOperationId)
+	d.Set("instance_id", instanceID)
+	d.Set("description", resp.HoursOfOperation.Description)
+	d.Set("name", resp.HoursOfOperation.Name)
+	d.Set("time_zone", resp.HoursOfOperation.TimeZone)
+
+	if err := d.Set("config", flattenHoursOfOperationConfigs(resp.HoursOfOperation.Config)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting config: %w", err))
+	}
+
+	tags := KeyValueTags(resp.HoursOfOperation.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceHoursOfOperationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, hoursOfOperationID, err := HoursOfOperationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("config", "description", "name", "time_zone") {
+		_, err = conn.UpdateHoursOfOperationWithContext(ctx, &connect.UpdateHoursOfOperationInput{
+			Config:             expandHoursOfOperationConfigs(d.Get("config").(*schema.Set).List()),
+			Description:        aws.String(d.Get("description").(string)),
+			HoursOfOperationId: aws.String(hoursOfOperationID),
+			InstanceId:         aws.String(instanceID),
+			Name:               aws.String(d.Get("name").(string)),
+			TimeZone:           aws.String(d.Get("time_zone").(string)),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Hours of Operation (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceHoursOfOperationRead(ctx, d, meta)
+}
+
+func resourceHoursOfOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, hoursOfOperationID, err := HoursOfOperationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DeleteHoursOfOperationWithContext(ctx, &connect.DeleteHoursOfOperationInput{
+		HoursOfOperationId: aws.String(hoursOfOperationID),
+		InstanceId:         aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Hours of Operation (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandHoursOfOperationConfigs(tfList []interface{}) []*connect.HoursOfOperationConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]*connect.HoursOfOperationConfig, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &connect.HoursOfOperationConfig{
+			Day: aws.String(tfMap["day"].(string)),
+		}
+
+		if v, ok := tfMap["end_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EndTime = expandHoursOfOperationTimeSlice(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["start_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.StartTime = expandHoursOfOperationTimeSlice(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandHoursOfOperationTimeSlice(tfMap map[string]interface{}) *connect.HoursOfOperationTimeSlice {
+	return &connect.HoursOfOperationTimeSlice{
+		Hours:   aws.Int64(int64(tfMap["hours"].(int))),
+		Minutes: aws.Int64(int64(tfMap["minutes"].(int))),
+	}
+}
+
+func flattenHoursOfOperationConfigs(apiObjects []*connect.HoursOfOperationConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"day": aws.StringValue(apiObject.Day),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = []interface{}{flattenHoursOfOperationTimeSlice(v)}
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap["start_time"] = []interface{}{flattenHoursOfOperationTimeSlice(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenHoursOfOperationTimeSlice(apiObject *connect.HoursOfOperationTimeSlice) map[string]interface{} {
+	return map[string]interface{}{
+		"hours":   aws.Int64Value(apiObject.Hours),
+		"minutes": aws.Int64Value(apiObject.Minutes),
+	}
+}
+
+func HoursOfOperationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:hoursOfOperationID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
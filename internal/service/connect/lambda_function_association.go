@@ -0,0 +1,171 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLambdaFunctionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLambdaFunctionAssociationCreate,
+		ReadContext:   resourceLambdaFunctionAssociationRead,
+		DeleteContext: resourceLambdaFunctionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceLambdaFunctionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Get("instance_id").(string)
+	functionArn := d.Get("function_arn").(string)
+
+	input := &connect.AssociateLambdaFunctionInput{
+		FunctionArn: aws.String(functionArn),
+		InstanceId:  aws.String(instanceID),
+	}
+
+	log.Printf("[DEBUG] Creating Connect Lambda Function Association %s", input)
+	_, err := conn.AssociateLambdaFunctionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Lambda Function Association (%s,%s): %w", instanceID, functionArn, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, functionArn))
+
+	return resourceLambdaFunctionAssociationRead(ctx, d, meta)
+}
+
+func resourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, functionArn, err := LambdaFunctionAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindLambdaFunctionAssociationByARN(ctx, conn, instanceID, functionArn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Lambda Function Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Lambda Function Association (%s): %w", d.Id(), err))
+	}
+
+	d.Set("function_arn", functionArn)
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
+
+func resourceLambdaFunctionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, functionArn, err := LambdaFunctionAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DisassociateLambdaFunctionWithContext(ctx, &connect.DisassociateLambdaFunctionInput{
+		FunctionArn: aws.String(functionArn),
+		InstanceId:  aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Lambda Function Association (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// FindLambdaFunctionAssociationByARN returns the ARN of the Lambda function if it is associated with the Connect instance.
+func FindLambdaFunctionAssociationByARN(ctx context.Context, conn *connect.Connect, instanceID, functionArn string) (string, error) {
+	var result string
+
+	input := &connect.ListLambdaFunctionsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListLambdaFunctionsMaxResults),
+	}
+
+	err := conn.ListLambdaFunctionsPagesWithContext(ctx, input, func(page *connect.ListLambdaFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LambdaFunctions {
+			if aws.StringValue(v) == functionArn {
+				result = functionArn
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func LambdaFunctionAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:functionARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,44 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConnectLambdaFunctionAssociationDataSource_functionARN(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_lambda_function_association.test"
+	datasourceName := "data.aws_connect_lambda_function_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLambdaFunctionAssociationDataSourceConfig_FunctionARN(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "function_arn", resourceName, "function_arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLambdaFunctionAssociationDataSourceConfig_FunctionARN(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccLambdaFunctionAssociationBasicConfig(rName, rName2),
+		`
+data "aws_connect_lambda_function_association" "test" {
+  instance_id  = aws_connect_lambda_function_association.test.instance_id
+  function_arn = aws_connect_lambda_function_association.test.function_arn
+}
+`)
+}
@@ -0,0 +1,184 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectLambdaFunctionAssociation_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccLambdaFunctionAssociation_basic,
+		"disappears": testAccLambdaFunctionAssociation_disappears,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccLambdaFunctionAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_lambda_function_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLambdaFunctionAssociationBasicConfig(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaFunctionAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLambdaFunctionAssociation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_lambda_function_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLambdaFunctionAssociationBasicConfig(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaFunctionAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceLambdaFunctionAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLambdaFunctionAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Lambda Function Association not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Lambda Function Association ID not set")
+		}
+		instanceID, functionArn, err := tfconnect.LambdaFunctionAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		_, err = tfconnect.FindLambdaFunctionAssociationByARN(context.Background(), conn, instanceID, functionArn)
+
+		return err
+	}
+}
+
+func testAccCheckLambdaFunctionAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_lambda_function_association" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		instanceID, functionArn, err := tfconnect.LambdaFunctionAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfconnect.FindLambdaFunctionAssociationByARN(context.Background(), conn, instanceID, functionArn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Lambda Function Association %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccLambdaFunctionAssociationBaseConfig(rName, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[2]q
+
+  assume_role_policy = jsonencode({
+    "Version" : "2012-10-17",
+    "Statement" : [
+      {
+        "Action" : "sts:AssumeRole",
+        "Principal" : {
+          "Service" : "lambda.amazonaws.com"
+        },
+        "Effect" : "Allow",
+        "Sid" : ""
+      }
+    ]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename         = "testdata/lambdatest.zip"
+  source_code_hash = filebase64sha256("testdata/lambdatest.zip")
+  function_name    = %[2]q
+  role             = aws_iam_role.test.arn
+  handler          = "exports.example"
+  runtime          = "nodejs14.x"
+}
+`, rName, rName2)
+}
+
+func testAccLambdaFunctionAssociationBasicConfig(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccLambdaFunctionAssociationBaseConfig(rName, rName2),
+		`
+resource "aws_connect_lambda_function_association" "test" {
+  instance_id  = aws_connect_instance.test.id
+  function_arn = aws_lambda_function.test.arn
+}
+`)
+}
@@ -0,0 +1,203 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectQuickConnect_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccQuickConnect_basic,
+		"disappears": testAccQuickConnect_disappears,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccQuickConnect_basic(t *testing.T) {
+	var v connect.DescribeQuickConnectOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_quick_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuickConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickConnectPhoneNumberConfig(rName, rName2, "Created", "+12345678912"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickConnectExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.quick_connect_type", connect.QuickConnectTypePhoneNumber),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.phone_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.phone_config.0.phone_number", "+12345678912"),
+					resource.TestCheckResourceAttrSet(resourceName, "quick_connect_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuickConnectPhoneNumberConfig(rName, rName2, "Updated", "+12345678913"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuickConnectExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.phone_config.0.phone_number", "+12345678913"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQuickConnect_disappears(t *testing.T) {
+	var v connect.DescribeQuickConnectOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_quick_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuickConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickConnectPhoneNumberConfig(rName, rName2, "Disappear", "+12345678912"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickConnectExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceQuickConnect(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickConnectExists(resourceName string, function *connect.DescribeQuickConnectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Quick Connect not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Quick Connect ID not set")
+		}
+		instanceID, quickConnectID, err := tfconnect.QuickConnectParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		params := &connect.DescribeQuickConnectInput{
+			InstanceId:     aws.String(instanceID),
+			QuickConnectId: aws.String(quickConnectID),
+		}
+
+		getFunction, err := conn.DescribeQuickConnect(params)
+		if err != nil {
+			return err
+		}
+
+		*function = *getFunction
+
+		return nil
+	}
+}
+
+func testAccCheckQuickConnectDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_quick_connect" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		instanceID, quickConnectID, err := tfconnect.QuickConnectParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		params := &connect.DescribeQuickConnectInput{
+			InstanceId:     aws.String(instanceID),
+			QuickConnectId: aws.String(quickConnectID),
+		}
+
+		_, err = conn.DescribeQuickConnect(params)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Quick Connect %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccQuickConnectBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccQuickConnectPhoneNumberConfig(rName, rName2, label, phoneNumber string) string {
+	return acctest.ConfigCompose(
+		testAccQuickConnectBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_quick_connect" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+
+  quick_connect_config {
+    quick_connect_type = "PHONE_NUMBER"
+
+    phone_config {
+      phone_number = %[3]q
+    }
+  }
+
+  tags = {
+    "Name" = "Test Quick Connect"
+  }
+}
+`, rName2, label, phoneNumber))
+}
@@ -0,0 +1,546 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// The queue association APIs accept at most 10 queue configs per call.
+const routingProfileQueuesMaxBatchSize = 10
+
+func ResourceRoutingProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoutingProfileCreate,
+		ReadContext:   resourceRoutingProfileRead,
+		UpdateContext: resourceRoutingProfileUpdate,
+		// Routing profiles do not support deletion today. NoOp the Delete method.
+		// Users can rename their routing profiles manually if they want.
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_outbound_queue_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"media_concurrencies": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.Channel_Values(), false),
+						},
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"queue_configs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.Channel_Values(), false),
+						},
+						"delay": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 9999),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						"queue_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"queue_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"routing_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceRoutingProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateRoutingProfileInput{
+		DefaultOutboundQueueId: aws.String(d.Get("default_outbound_queue_id").(string)),
+		Description:            aws.String(d.Get("description").(string)),
+		InstanceId:             aws.String(instanceID),
+		MediaConcurrencies:     expandRoutingProfileMediaConcurrencies(d.Get("media_concurrencies").(*schema.Set).List()),
+		Name:                   aws.String(name),
+	}
+
+	queueConfigs := expandRoutingProfileQueueConfigs(d.Get("queue_configs").(*schema.Set).List())
+
+	// Only the first batch of queue configs can be passed at creation time.
+	if len(queueConfigs) > 0 {
+		n := len(queueConfigs)
+		if n > routingProfileQueuesMaxBatchSize {
+			n = routingProfileQueuesMaxBatchSize
+		}
+
+		input.QueueConfigs = queueConfigs[:n]
+		queueConfigs = queueConfigs[n:]
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Connect Routing Profile %s", input)
+	output, err := conn.CreateRoutingProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Routing Profile (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Routing Profile (%s): empty output", name))
+	}
+
+	routingProfileID := aws.StringValue(output.RoutingProfileId)
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, routingProfileID))
+
+	if len(queueConfigs) > 0 {
+		if err := associateRoutingProfileQueues(ctx, conn, instanceID, routingProfileID, queueConfigs); err != nil {
+			return diag.FromErr(fmt.Errorf("error associating Connect Routing Profile (%s) queues: %w", d.Id(), err))
+		}
+	}
+
+	return resourceRoutingProfileRead(ctx, d, meta)
+}
+
+func resourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := conn.DescribeRoutingProfileWithContext(ctx, &connect.DescribeRoutingProfileInput{
+		InstanceId:       aws.String(instanceID),
+		RoutingProfileId: aws.String(routingProfileID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Routing Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): %w", d.Id(), err))
+	}
+
+	if resp == nil || resp.RoutingProfile == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): empty response", d.Id()))
+	}
+
+	d.Set("arn", resp.RoutingProfile.RoutingProfileArn)
+	d.Set("default_outbound_queue_id", resp.RoutingProfile.DefaultOutboundQueueId)
+	d.Set("description", resp.RoutingProfile.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", resp.RoutingProfile.Name)
+	d.Set("routing_profile_id", resp.RoutingProfile.RoutingProfileId)
+
+	if err := d.Set("media_concurrencies", flattenRoutingProfileMediaConcurrencies(resp.RoutingProfile.MediaConcurrencies)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting media_concurrencies: %w", err))
+	}
+
+	queueConfigs, err := getRoutingProfileQueueConfigs(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Connect Routing Profile (%s) queues: %w", d.Id(), err))
+	}
+
+	if err := d.Set("queue_configs", flattenRoutingProfileQueueConfigSummaries(queueConfigs)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting queue_configs: %w", err))
+	}
+
+	tags := KeyValueTags(resp.RoutingProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "name") {
+		_, err = conn.UpdateRoutingProfileNameWithContext(ctx, &connect.UpdateRoutingProfileNameInput{
+			Description:      aws.String(d.Get("description").(string)),
+			InstanceId:       aws.String(instanceID),
+			Name:             aws.String(d.Get("name").(string)),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) name: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("default_outbound_queue_id") {
+		_, err = conn.UpdateRoutingProfileDefaultOutboundQueueWithContext(ctx, &connect.UpdateRoutingProfileDefaultOutboundQueueInput{
+			DefaultOutboundQueueId: aws.String(d.Get("default_outbound_queue_id").(string)),
+			InstanceId:             aws.String(instanceID),
+			RoutingProfileId:       aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) default outbound queue: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("media_concurrencies") {
+		_, err = conn.UpdateRoutingProfileConcurrencyWithContext(ctx, &connect.UpdateRoutingProfileConcurrencyInput{
+			InstanceId:         aws.String(instanceID),
+			MediaConcurrencies: expandRoutingProfileMediaConcurrencies(d.Get("media_concurrencies").(*schema.Set).List()),
+			RoutingProfileId:   aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) media concurrencies: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("queue_configs") {
+		o, n := d.GetChange("queue_configs")
+
+		if err := updateRoutingProfileQueues(ctx, conn, instanceID, routingProfileID, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) queues: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceRoutingProfileRead(ctx, d, meta)
+}
+
+// updateRoutingProfileQueues reconciles the queues associated with a routing profile.
+// Queue configs are keyed by queue ID and channel: removed keys are disassociated,
+// new keys are associated and keys whose delay or priority changed are updated in place.
+func updateRoutingProfileQueues(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, o, n []interface{}) error {
+	key := func(v *connect.RoutingProfileQueueConfig) string {
+		return aws.StringValue(v.QueueReference.QueueId) + ":" + aws.StringValue(v.QueueReference.Channel)
+	}
+
+	oldConfigs := make(map[string]*connect.RoutingProfileQueueConfig)
+	for _, v := range expandRoutingProfileQueueConfigs(o) {
+		oldConfigs[key(v)] = v
+	}
+
+	newConfigs := make(map[string]*connect.RoutingProfileQueueConfig)
+	for _, v := range expandRoutingProfileQueueConfigs(n) {
+		newConfigs[key(v)] = v
+	}
+
+	var del []*connect.RoutingProfileQueueReference
+	var add, update []*connect.RoutingProfileQueueConfig
+
+	for k, v := range oldConfigs {
+		if _, ok := newConfigs[k]; !ok {
+			del = append(del, v.QueueReference)
+		}
+	}
+
+	for k, v := range newConfigs {
+		old, ok := oldConfigs[k]
+
+		if !ok {
+			add = append(add, v)
+			continue
+		}
+
+		if aws.Int64Value(old.Delay) != aws.Int64Value(v.Delay) || aws.Int64Value(old.Priority) != aws.Int64Value(v.Priority) {
+			update = append(update, v)
+		}
+	}
+
+	for i := 0; i < len(del); i += routingProfileQueuesMaxBatchSize {
+		j := i + routingProfileQueuesMaxBatchSize
+		if j > len(del) {
+			j = len(del)
+		}
+
+		_, err := conn.DisassociateRoutingProfileQueuesWithContext(ctx, &connect.DisassociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueReferences:  del[i:j],
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disassociating queues: %w", err)
+		}
+	}
+
+	if err := associateRoutingProfileQueues(ctx, conn, instanceID, routingProfileID, add); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(update); i += routingProfileQueuesMaxBatchSize {
+		j := i + routingProfileQueuesMaxBatchSize
+		if j > len(update) {
+			j = len(update)
+		}
+
+		_, err := conn.UpdateRoutingProfileQueuesWithContext(ctx, &connect.UpdateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     update[i:j],
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating queues: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func associateRoutingProfileQueues(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, queueConfigs []*connect.RoutingProfileQueueConfig) error {
+	for i := 0; i < len(queueConfigs); i += routingProfileQueuesMaxBatchSize {
+		j := i + routingProfileQueuesMaxBatchSize
+		if j > len(queueConfigs) {
+			j = len(queueConfigs)
+		}
+
+		_, err := conn.AssociateRoutingProfileQueuesWithContext(ctx, &connect.AssociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     queueConfigs[i:j],
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error associating queues: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func getRoutingProfileQueueConfigs(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) ([]*connect.RoutingProfileQueueConfigSummary, error) {
+	var result []*connect.RoutingProfileQueueConfigSummary
+
+	input := &connect.ListRoutingProfileQueuesInput{
+		InstanceId:       aws.String(instanceID),
+		MaxResults:       aws.Int64(ListRoutingProfileQueuesMaxResults),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+
+	err := conn.ListRoutingProfileQueuesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfileQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RoutingProfileQueueConfigSummaryList {
+			if v == nil {
+				continue
+			}
+
+			result = append(result, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func expandRoutingProfileMediaConcurrencies(tfList []interface{}) []*connect.MediaConcurrency {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]*connect.MediaConcurrency, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &connect.MediaConcurrency{
+			Channel:     aws.String(tfMap["channel"].(string)),
+			Concurrency: aws.Int64(int64(tfMap["concurrency"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRoutingProfileMediaConcurrencies(apiObjects []*connect.MediaConcurrency) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":     aws.StringValue(apiObject.Channel),
+			"concurrency": aws.Int64Value(apiObject.Concurrency),
+		})
+	}
+
+	return tfList
+}
+
+func expandRoutingProfileQueueConfigs(tfList []interface{}) []*connect.RoutingProfileQueueConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]*connect.RoutingProfileQueueConfig, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &connect.RoutingProfileQueueConfig{
+			Delay:    aws.Int64(int64(tfMap["delay"].(int))),
+			Priority: aws.Int64(int64(tfMap["priority"].(int))),
+			QueueReference: &connect.RoutingProfileQueueReference{
+				Channel: aws.String(tfMap["channel"].(string)),
+				QueueId: aws.String(tfMap["queue_id"].(string)),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRoutingProfileQueueConfigSummaries(apiObjects []*connect.RoutingProfileQueueConfigSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":    aws.StringValue(apiObject.Channel),
+			"delay":      aws.Int64Value(apiObject.Delay),
+			"priority":   aws.Int64Value(apiObject.Priority),
+			"queue_arn":  aws.StringValue(apiObject.QueueArn),
+			"queue_id":   aws.StringValue(apiObject.QueueId),
+			"queue_name": aws.StringValue(apiObject.QueueName),
+		})
+	}
+
+	return tfList
+}
+
+func RoutingProfileParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:routingProfileID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
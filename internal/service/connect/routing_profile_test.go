@@ -0,0 +1,308 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectRoutingProfile_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":        testAccRoutingProfile_basic,
+		"queueConfigs": testAccRoutingProfile_queueConfigs,
+		"disappears":   testAccRoutingProfile_disappears_ConnectInstance,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccRoutingProfile_basic(t *testing.T) {
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoutingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Created", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_outbound_queue_id", "data.aws_connect_queue.test", "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "media_concurrencies.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":     connect.ChannelVoice,
+						"concurrency": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Updated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "media_concurrencies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":     connect.ChannelChat,
+						"concurrency": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingProfile_queueConfigs(t *testing.T) {
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoutingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingProfileQueueConfigsConfig(rName, rName2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "queue_configs.*", map[string]string{
+						"channel":  connect.ChannelVoice,
+						"delay":    "2",
+						"priority": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingProfileQueueConfigsConfig(rName, rName2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "queue_configs.*", map[string]string{
+						"channel":  connect.ChannelVoice,
+						"delay":    "2",
+						"priority": "3",
+					}),
+				),
+			},
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Test", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Can't delete a routing profile. Test deletion of entire connect instance
+func testAccRoutingProfile_disappears_ConnectInstance(t *testing.T) {
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+	instanceResourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoutingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Disappear", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceInstance(), instanceResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoutingProfileExists(resourceName string, function *connect.DescribeRoutingProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Routing Profile not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Routing Profile ID not set")
+		}
+		instanceID, routingProfileID, err := tfconnect.RoutingProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		params := &connect.DescribeRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(routingProfileID),
+		}
+
+		getFunction, err := conn.DescribeRoutingProfile(params)
+		if err != nil {
+			return err
+		}
+
+		*function = *getFunction
+
+		return nil
+	}
+}
+
+func testAccCheckRoutingProfileDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_routing_profile" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		instanceID, routingProfileID, err := tfconnect.RoutingProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		params := &connect.DescribeRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(routingProfileID),
+		}
+
+		_, err = conn.DescribeRoutingProfile(params)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Routing Profile %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccRoutingProfileBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+data "aws_connect_queue" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = "BasicQueue"
+}
+`, rName)
+}
+
+func testAccRoutingProfileBasicConfig(rName, rName2, label string, numChannels int) string {
+	mediaConcurrencies := `
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+`
+
+	if numChannels > 1 {
+		mediaConcurrencies += `
+  media_concurrencies {
+    channel     = "CHAT"
+    concurrency = 2
+  }
+`
+	}
+
+	return acctest.ConfigCompose(
+		testAccRoutingProfileBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  description               = %[2]q
+  default_outbound_queue_id = data.aws_connect_queue.test.queue_id
+%[3]s
+  tags = {
+    "Name" = "Test Routing Profile"
+  }
+}
+`, rName2, label, mediaConcurrencies))
+}
+
+func testAccRoutingProfileQueueConfigsConfig(rName, rName2 string, priority int) string {
+	return acctest.ConfigCompose(
+		testAccRoutingProfileBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  description               = "Test"
+  default_outbound_queue_id = data.aws_connect_queue.test.queue_id
+
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+
+  queue_configs {
+    channel  = "VOICE"
+    delay    = 2
+    priority = %[2]d
+    queue_id = data.aws_connect_queue.test.queue_id
+  }
+
+  tags = {
+    "Name" = "Test Routing Profile"
+  }
+}
+`, rName2, priority))
+}
@@ -0,0 +1,153 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceSecurityProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecurityProfileRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "security_profile_id"},
+			},
+			"organization_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"security_profile_id", "name"},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.DescribeSecurityProfileInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	if v, ok := d.GetOk("security_profile_id"); ok {
+		input.SecurityProfileId = aws.String(v.(string))
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		securityProfileSummary, err := dataSourceGetSecurityProfileSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error finding Connect Security Profile Summary by name (%s): %w", name, err))
+		}
+
+		if securityProfileSummary == nil {
+			return diag.FromErr(fmt.Errorf("error finding Connect Security Profile Summary by name (%s): not found", name))
+		}
+
+		input.SecurityProfileId = securityProfileSummary.Id
+	}
+
+	resp, err := conn.DescribeSecurityProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Security Profile: %w", err))
+	}
+
+	if resp == nil || resp.SecurityProfile == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Security Profile: empty response"))
+	}
+
+	securityProfile := resp.SecurityProfile
+	securityProfileID := aws.StringValue(securityProfile.Id)
+
+	d.Set("arn", securityProfile.Arn)
+	d.Set("description", securityProfile.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", securityProfile.SecurityProfileName)
+	d.Set("organization_resource_id", securityProfile.OrganizationResourceId)
+	d.Set("security_profile_id", securityProfileID)
+
+	permissions, err := getSecurityProfilePermissions(ctx, conn, instanceID, securityProfileID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Connect Security Profile (%s) permissions: %w", securityProfileID, err))
+	}
+
+	d.Set("permissions", aws.StringValueSlice(permissions))
+
+	if err := d.Set("tags", KeyValueTags(securityProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, securityProfileID))
+
+	return nil
+}
+
+func dataSourceGetSecurityProfileSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.SecurityProfileSummary, error) {
+	var result *connect.SecurityProfileSummary
+
+	input := &connect.ListSecurityProfilesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListSecurityProfilesMaxResults),
+	}
+
+	err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityProfileSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,451 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"hierarchy_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"identity_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"first_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"last_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"phone_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_contact_work_time_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"auto_accept": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"desk_phone_number": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"phone_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.PhoneType_Values(), false),
+						},
+					},
+				},
+			},
+			"routing_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"security_profile_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateUserInput{
+		InstanceId:         aws.String(instanceID),
+		PhoneConfig:        expandUserPhoneConfig(d.Get("phone_config").([]interface{})),
+		RoutingProfileId:   aws.String(d.Get("routing_profile_id").(string)),
+		SecurityProfileIds: flex.ExpandStringSet(d.Get("security_profile_ids").(*schema.Set)),
+		Username:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("directory_user_id"); ok {
+		input.DirectoryUserId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hierarchy_group_id"); ok {
+		input.HierarchyGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("identity_info"); ok {
+		input.IdentityInfo = expandUserIdentityInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		input.Password = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Connect User (%s)", name)
+	output, err := conn.CreateUserWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect User (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect User (%s): empty output", name))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.UserId)))
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID, userID, err := UserParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := conn.DescribeUserWithContext(ctx, &connect.DescribeUserInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(userID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect User (%s): %w", d.Id(), err))
+	}
+
+	if resp == nil || resp.User == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect User (%s): empty response", d.Id()))
+	}
+
+	d.Set("arn", resp.User.Arn)
+	d.Set("directory_user_id", resp.User.DirectoryUserId)
+	d.Set("hierarchy_group_id", resp.User.HierarchyGroupId)
+	d.Set("instance_id", instanceID)
+	d.Set("name", resp.User.Username)
+	d.Set("routing_profile_id", resp.User.RoutingProfileId)
+	d.Set("security_profile_ids", aws.StringValueSlice(resp.User.SecurityProfileIds))
+	d.Set("user_id", resp.User.Id)
+
+	if err := d.Set("identity_info", flattenUserIdentityInfo(resp.User.IdentityInfo)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting identity_info: %w", err))
+	}
+
+	if err := d.Set("phone_config", flattenUserPhoneConfig(resp.User.PhoneConfig)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting phone_config: %w", err))
+	}
+
+	tags := KeyValueTags(resp.User.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, userID, err := UserParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("hierarchy_group_id") {
+		input := &connect.UpdateUserHierarchyInput{
+			InstanceId: aws.String(instanceID),
+			UserId:     aws.String(userID),
+		}
+
+		// An omitted hierarchy group ID removes the user from its current group.
+		if v, ok := d.GetOk("hierarchy_group_id"); ok {
+			input.HierarchyGroupId = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateUserHierarchyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect User (%s) hierarchy: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("identity_info") {
+		identityInfo := expandUserIdentityInfo(d.Get("identity_info").([]interface{}))
+
+		if identityInfo == nil {
+			identityInfo = &connect.UserIdentityInfo{}
+		}
+
+		_, err = conn.UpdateUserIdentityInfoWithContext(ctx, &connect.UpdateUserIdentityInfoInput{
+			IdentityInfo: identityInfo,
+			InstanceId:   aws.String(instanceID),
+			UserId:       aws.String(userID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect User (%s) identity info: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("phone_config") {
+		_, err = conn.UpdateUserPhoneConfigWithContext(ctx, &connect.UpdateUserPhoneConfigInput{
+			InstanceId:  aws.String(instanceID),
+			PhoneConfig: expandUserPhoneConfig(d.Get("phone_config").([]interface{})),
+			UserId:      aws.String(userID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect User (%s) phone config: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("routing_profile_id") {
+		_, err = conn.UpdateUserRoutingProfileWithContext(ctx, &connect.UpdateUserRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(d.Get("routing_profile_id").(string)),
+			UserId:           aws.String(userID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect User (%s) routing profile: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("security_profile_ids") {
+		_, err = conn.UpdateUserSecurityProfilesWithContext(ctx, &connect.UpdateUserSecurityProfilesInput{
+			InstanceId:         aws.String(instanceID),
+			SecurityProfileIds: flex.ExpandStringSet(d.Get("security_profile_ids").(*schema.Set)),
+			UserId:             aws.String(userID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect User (%s) security profiles: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, userID, err := UserParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DeleteUserWithContext(ctx, &connect.DeleteUserInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect User (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandUserIdentityInfo(tfList []interface{}) *connect.UserIdentityInfo {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connect.UserIdentityInfo{}
+
+	if v, ok := tfMap["email"].(string); ok && v != "" {
+		apiObject.Email = aws.String(v)
+	}
+
+	if v, ok := tfMap["first_name"].(string); ok && v != "" {
+		apiObject.FirstName = aws.String(v)
+	}
+
+	if v, ok := tfMap["last_name"].(string); ok && v != "" {
+		apiObject.LastName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenUserIdentityInfo(apiObject *connect.UserIdentityInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"email":      aws.StringValue(apiObject.Email),
+		"first_name": aws.StringValue(apiObject.FirstName),
+		"last_name":  aws.StringValue(apiObject.LastName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandUserPhoneConfig(tfList []interface{}) *connect.UserPhoneConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connect.UserPhoneConfig{
+		PhoneType: aws.String(tfMap["phone_type"].(string)),
+	}
+
+	if v, ok := tfMap["after_contact_work_time_limit"].(int); ok {
+		apiObject.AfterContactWorkTimeLimit = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["auto_accept"].(bool); ok {
+		apiObject.AutoAccept = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["desk_phone_number"].(string); ok && v != "" {
+		apiObject.DeskPhoneNumber = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenUserPhoneConfig(apiObject *connect.UserPhoneConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"after_contact_work_time_limit": aws.Int64Value(apiObject.AfterContactWorkTimeLimit),
+		"auto_accept":                   aws.BoolValue(apiObject.AutoAccept),
+		"desk_phone_number":             aws.StringValue(apiObject.DeskPhoneNumber),
+		"phone_type":                    aws.StringValue(apiObject.PhoneType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func UserParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:userID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,227 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceUserHierarchyStructure() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserHierarchyStructureCreate,
+		ReadContext:   resourceUserHierarchyStructureRead,
+		UpdateContext: resourceUserHierarchyStructureUpdate,
+		DeleteContext: resourceUserHierarchyStructureDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"hierarchy_structure": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level_five":  userHierarchyLevelSchema(),
+						"level_four":  userHierarchyLevelSchema(),
+						"level_one":   userHierarchyLevelSchema(),
+						"level_three": userHierarchyLevelSchema(),
+						"level_two":   userHierarchyLevelSchema(),
+					},
+				},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+// Each level within a user hierarchy structure shares the same schema.
+func userHierarchyLevelSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 50),
+				},
+			},
+		},
+	}
+}
+
+func resourceUserHierarchyStructureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.UpdateUserHierarchyStructureInput{
+		HierarchyStructure: expandUserHierarchyStructure(d.Get("hierarchy_structure").([]interface{})),
+		InstanceId:         aws.String(instanceID),
+	}
+
+	log.Printf("[DEBUG] Creating Connect User Hierarchy Structure %s", input)
+	_, err := conn.UpdateUserHierarchyStructureWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect User Hierarchy Structure for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	d.SetId(instanceID)
+
+	return resourceUserHierarchyStructureRead(ctx, d, meta)
+}
+
+func resourceUserHierarchyStructureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Id()
+
+	resp, err := conn.DescribeUserHierarchyStructureWithContext(ctx, &connect.DescribeUserHierarchyStructureInput{
+		InstanceId: aws.String(instanceID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect User Hierarchy Structure (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Structure (%s): %w", d.Id(), err))
+	}
+
+	if resp == nil || resp.HierarchyStructure == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Structure (%s): empty response", d.Id()))
+	}
+
+	if err := d.Set("hierarchy_structure", flattenUserHierarchyStructure(resp.HierarchyStructure)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting hierarchy_structure: %w", err))
+	}
+
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
+
+func resourceUserHierarchyStructureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	if d.HasChange("hierarchy_structure") {
+		_, err := conn.UpdateUserHierarchyStructureWithContext(ctx, &connect.UpdateUserHierarchyStructureInput{
+			HierarchyStructure: expandUserHierarchyStructure(d.Get("hierarchy_structure").([]interface{})),
+			InstanceId:         aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect User Hierarchy Structure (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceUserHierarchyStructureRead(ctx, d, meta)
+}
+
+func resourceUserHierarchyStructureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	// A user hierarchy structure cannot be deleted, only emptied.
+	_, err := conn.UpdateUserHierarchyStructureWithContext(ctx, &connect.UpdateUserHierarchyStructureInput{
+		HierarchyStructure: &connect.HierarchyStructureUpdate{},
+		InstanceId:         aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect User Hierarchy Structure (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandUserHierarchyStructure(tfList []interface{}) *connect.HierarchyStructureUpdate {
+	apiObject := &connect.HierarchyStructureUpdate{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject.LevelFive = expandUserHierarchyLevel(tfMap["level_five"].([]interface{}))
+	apiObject.LevelFour = expandUserHierarchyLevel(tfMap["level_four"].([]interface{}))
+	apiObject.LevelOne = expandUserHierarchyLevel(tfMap["level_one"].([]interface{}))
+	apiObject.LevelThree = expandUserHierarchyLevel(tfMap["level_three"].([]interface{}))
+	apiObject.LevelTwo = expandUserHierarchyLevel(tfMap["level_two"].([]interface{}))
+
+	return apiObject
+}
+
+func expandUserHierarchyLevel(tfList []interface{}) *connect.HierarchyLevelUpdate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &connect.HierarchyLevelUpdate{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+}
+
+func flattenUserHierarchyStructure(apiObject *connect.HierarchyStructure) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"level_five":  flattenUserHierarchyLevel(apiObject.LevelFive),
+		"level_four":  flattenUserHierarchyLevel(apiObject.LevelFour),
+		"level_one":   flattenUserHierarchyLevel(apiObject.LevelOne),
+		"level_three": flattenUserHierarchyLevel(apiObject.LevelThree),
+		"level_two":   flattenUserHierarchyLevel(apiObject.LevelTwo),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserHierarchyLevel(apiObject *connect.HierarchyLevel) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":  aws.StringValue(apiObject.Arn),
+		"id":   aws.StringValue(apiObject.Id),
+		"name": aws.StringValue(apiObject.Name),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,86 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceUserHierarchyStructure() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserHierarchyStructureRead,
+		Schema: map[string]*schema.Schema{
+			"hierarchy_structure": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level_five":  dataSourceUserHierarchyLevelSchema(),
+						"level_four":  dataSourceUserHierarchyLevelSchema(),
+						"level_one":   dataSourceUserHierarchyLevelSchema(),
+						"level_three": dataSourceUserHierarchyLevelSchema(),
+						"level_two":   dataSourceUserHierarchyLevelSchema(),
+					},
+				},
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceUserHierarchyLevelSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserHierarchyStructureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Get("instance_id").(string)
+
+	resp, err := conn.DescribeUserHierarchyStructureWithContext(ctx, &connect.DescribeUserHierarchyStructureInput{
+		InstanceId: aws.String(instanceID),
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Structure for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	if resp == nil || resp.HierarchyStructure == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Structure for Connect Instance (%s): empty response", instanceID))
+	}
+
+	if err := d.Set("hierarchy_structure", flattenUserHierarchyStructure(resp.HierarchyStructure)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting hierarchy_structure: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConnectUserHierarchyStructureDataSource_instanceID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_user_hierarchy_structure.test"
+	datasourceName := "data.aws_connect_user_hierarchy_structure.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserHierarchyStructureDataSourceConfig_InstanceID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.#", resourceName, "hierarchy_structure.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_one.0.arn", resourceName, "hierarchy_structure.0.level_one.0.arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_one.0.id", resourceName, "hierarchy_structure.0.level_one.0.id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_one.0.name", resourceName, "hierarchy_structure.0.level_one.0.name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_two.0.name", resourceName, "hierarchy_structure.0.level_two.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserHierarchyStructureDataSourceConfig_InstanceID(rName string) string {
+	return acctest.ConfigCompose(
+		testAccUserHierarchyStructureBasicConfig(rName, true),
+		`
+data "aws_connect_user_hierarchy_structure" "test" {
+  instance_id = aws_connect_user_hierarchy_structure.test.instance_id
+}
+`)
+}
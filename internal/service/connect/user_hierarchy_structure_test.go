@@ -0,0 +1,169 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectUserHierarchyStructure_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccUserHierarchyStructure_basic,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccUserHierarchyStructure_basic(t *testing.T) {
+	var v connect.DescribeUserHierarchyStructureOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_user_hierarchy_structure.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserHierarchyStructureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserHierarchyStructureBasicConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserHierarchyStructureExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_one.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "hierarchy_structure.0.level_one.0.arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "hierarchy_structure.0.level_one.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_one.0.name", "levelone"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_two.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserHierarchyStructureBasicConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserHierarchyStructureExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_one.0.name", "levelone"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_two.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_two.0.name", "leveltwo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserHierarchyStructureExists(resourceName string, function *connect.DescribeUserHierarchyStructureOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect User Hierarchy Structure not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect User Hierarchy Structure ID not set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		params := &connect.DescribeUserHierarchyStructureInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+
+		getFunction, err := conn.DescribeUserHierarchyStructure(params)
+		if err != nil {
+			return err
+		}
+
+		*function = *getFunction
+
+		return nil
+	}
+}
+
+func testAccCheckUserHierarchyStructureDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_user_hierarchy_structure" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		params := &connect.DescribeUserHierarchyStructureInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+
+		resp, err := conn.DescribeUserHierarchyStructure(params)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// An emptied hierarchy structure is considered destroyed.
+		if resp == nil || resp.HierarchyStructure == nil || resp.HierarchyStructure.LevelOne == nil {
+			continue
+		}
+
+		return fmt.Errorf("Connect User Hierarchy Structure %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccUserHierarchyStructureBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccUserHierarchyStructureBasicConfig(rName string, levelTwo bool) string {
+	levelTwoConfig := ""
+
+	if levelTwo {
+		levelTwoConfig = `
+    level_two {
+      name = "leveltwo"
+    }
+`
+	}
+
+	return acctest.ConfigCompose(
+		testAccUserHierarchyStructureBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_user_hierarchy_structure" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  hierarchy_structure {
+    level_one {
+      name = "levelone"
+    }
+%[1]s
+  }
+}
+`, levelTwoConfig))
+}
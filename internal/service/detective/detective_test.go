@@ -0,0 +1,38 @@
+package detective_test
+
+import (
+	"testing"
+)
+
+func TestAccDetective_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Graph": {
+			"basic":      testAccGraph_basic,
+			"disappears": testAccGraph_disappears,
+			"tags":       testAccGraph_tags,
+		},
+		"InvitationAccepter": {
+			"basic": testAccInvitationAccepter_basic,
+		},
+		"Member": {
+			"basic":      testAccMember_basic,
+			"disappears": testAccMember_disappears,
+			"message":    testAccMember_message,
+		},
+		"OrganizationAdminAccount": {
+			"basic": testAccOrganizationAdminAccount_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
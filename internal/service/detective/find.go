@@ -0,0 +1,157 @@
+package detective
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGraphByARN(conn *detective.Detective, arn string) (*detective.Graph, error) {
+	input := &detective.ListGraphsInput{}
+	var result *detective.Graph
+
+	err := conn.ListGraphsPages(input, func(page *detective.ListGraphsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, graph := range page.GraphList {
+			if graph == nil {
+				continue
+			}
+
+			if aws.StringValue(graph.Arn) == arn {
+				result = graph
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+func FindMemberByGraphARNAndAccountID(conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	input := &detective.GetMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	}
+
+	output, err := conn.GetMembers(input)
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, member := range output.MemberDetails {
+		if member == nil {
+			continue
+		}
+
+		if aws.StringValue(member.AccountId) == accountID {
+			return member, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// FindInvitationByGraphARN returns the membership of the current account in the
+// specified behavior graph, as seen from the member account.
+func FindInvitationByGraphARN(conn *detective.Detective, graphARN string) (*detective.MemberDetail, error) {
+	input := &detective.ListInvitationsInput{}
+	var result *detective.MemberDetail
+
+	err := conn.ListInvitationsPages(input, func(page *detective.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, invitation := range page.Invitations {
+			if invitation == nil {
+				continue
+			}
+
+			if aws.StringValue(invitation.GraphArn) == graphARN {
+				result = invitation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+func FindOrganizationAdminAccountByAccountID(conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	input := &detective.ListOrganizationAdminAccountsInput{}
+	var result *detective.Administrator
+
+	err := conn.ListOrganizationAdminAccountsPages(input, func(page *detective.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, administrator := range page.Administrators {
+			if administrator == nil {
+				continue
+			}
+
+			if aws.StringValue(administrator.AccountId) == accountID {
+				result = administrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,140 @@
+package detective
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGraph() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGraphCreate,
+		Read:   resourceGraphRead,
+		Update: resourceGraphUpdate,
+		Delete: resourceGraphDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGraphCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &detective.CreateGraphInput{}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Detective Graph: %s", input)
+	output, err := conn.CreateGraph(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Detective Graph: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.GraphArn))
+
+	return resourceGraphRead(d, meta)
+}
+
+func resourceGraphRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	graph, err := FindGraphByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Graph (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_time", aws.TimeValue(graph.CreatedTime).Format(time.RFC3339))
+	d.Set("graph_arn", graph.Arn)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGraphUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Detective Graph (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGraphRead(d, meta)
+}
+
+func resourceGraphDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	log.Printf("[DEBUG] Deleting Detective Graph: %s", d.Id())
+	_, err := conn.DeleteGraph(&detective.DeleteGraphInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package detective_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccGraph_basic(t *testing.T) {
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(detective.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "graph_arn", "detective", regexp.MustCompile(`graph:.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "graph_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGraph_disappears(t *testing.T) {
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(detective.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdetective.ResourceGraph(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccGraph_tags(t *testing.T) {
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(detective.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGraphConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGraphExists(n string, v *detective.Graph) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective Graph ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+		output, err := tfdetective.FindGraphByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckGraphDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_graph" {
+			continue
+		}
+
+		_, err := tfdetective.FindGraphByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Detective Graph %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGraphConfig() string {
+	return `
+resource "aws_detective_graph" "test" {}
+`
+}
+
+func testAccGraphConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccGraphConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
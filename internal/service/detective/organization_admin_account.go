@@ -0,0 +1,100 @@
+package detective
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOrganizationAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrganizationAdminAccountCreate,
+		Read:   resourceOrganizationAdminAccountRead,
+		Delete: resourceOrganizationAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	accountID := d.Get("account_id").(string)
+	input := &detective.EnableOrganizationAdminAccountInput{
+		AccountId: aws.String(accountID),
+	}
+
+	log.Printf("[DEBUG] Enabling Detective Organization Admin Account: %s", input)
+	_, err := conn.EnableOrganizationAdminAccount(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Detective Organization Admin Account (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitOrganizationAdminAccountEnabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Detective Organization Admin Account (%s) to enable: %w", d.Id(), err)
+	}
+
+	return resourceOrganizationAdminAccountRead(d, meta)
+}
+
+func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	administrator, err := FindOrganizationAdminAccountByAccountID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Organization Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Organization Admin Account (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", administrator.AccountId)
+	d.Set("graph_arn", administrator.GraphArn)
+
+	return nil
+}
+
+func resourceOrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	log.Printf("[DEBUG] Disabling Detective Organization Admin Account: %s", d.Id())
+	_, err := conn.DisableOrganizationAdminAccount(&detective.DisableOrganizationAdminAccountInput{})
+
+	if err != nil {
+		return fmt.Errorf("error disabling Detective Organization Admin Account (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationAdminAccountDisabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Detective Organization Admin Account (%s) to disable: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package detective
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	organizationAdminAccountStatusEnabled = "ENABLED"
+)
+
+func statusMember(conn *detective.Detective, graphARN, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusOrganizationAdminAccount(conn *detective.Detective, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationAdminAccountByAccountID(conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, organizationAdminAccountStatusEnabled, nil
+	}
+}
@@ -0,0 +1,66 @@
+package detective
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an organization admin account to be enabled or disabled
+	organizationAdminAccountTimeout = 5 * time.Minute
+)
+
+func waitMemberInvited(conn *detective.Detective, graphARN, accountID string, timeout time.Duration) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{detective.MemberStatusVerificationInProgress},
+		Target:  []string{detective.MemberStatusInvited, detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled},
+		Refresh: statusMember(conn, graphARN, accountID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationAdminAccountEnabled(conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{organizationAdminAccountStatusEnabled},
+		Refresh:                   statusOrganizationAdminAccount(conn, accountID),
+		Timeout:                   organizationAdminAccountTimeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.Administrator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationAdminAccountDisabled(conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationAdminAccountStatusEnabled},
+		Target:  []string{},
+		Refresh: statusOrganizationAdminAccount(conn, accountID),
+		Timeout: organizationAdminAccountTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.Administrator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -21,6 +21,9 @@ func ResourceDefaultVPC() *schema.Resource {
 		Type:     schema.TypeString,
 		Computed: true,
 	}
+	// Default VPCs are not allocated from IPAM pools
+	delete(dvpc.Schema, "ipv4_ipam_pool_id")
+	delete(dvpc.Schema, "ipv4_netmask_length")
 	// instance_tenancy is a computed value for Default VPCs
 	dvpc.Schema["instance_tenancy"] = &schema.Schema{
 		Type:     schema.TypeString,
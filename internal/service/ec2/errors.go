@@ -33,6 +33,18 @@ const (
 	ErrCodeInvalidInternetGatewayIDNotFound = "InvalidInternetGatewayID.NotFound"
 )
 
+const (
+	ErrCodeInvalidIPAMIdNotFound               = "InvalidIpamId.NotFound"
+	ErrCodeInvalidIPAMPoolAllocationIdNotFound = "InvalidIpamPoolAllocationId.NotFound"
+	ErrCodeInvalidIPAMPoolIdNotFound           = "InvalidIpamPoolId.NotFound"
+	ErrCodeInvalidIPAMScopeIdNotFound          = "InvalidIpamScopeId.NotFound"
+)
+
+const (
+	ErrCodeInvalidNetworkInsightsAnalysisIdNotFound = "InvalidNetworkInsightsAnalysisId.NotFound"
+	ErrCodeInvalidNetworkInsightsPathIdNotFound     = "InvalidNetworkInsightsPathId.NotFound"
+)
+
 const (
 	ErrCodeInvalidNetworkInterfaceIDNotFound = "InvalidNetworkInterfaceID.NotFound"
 )
@@ -48,7 +60,10 @@ const (
 )
 
 const (
-	ErrCodeInvalidTransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayAttachmentIDNotFound      = "InvalidTransitGatewayAttachmentID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound     = "InvalidTransitGatewayConnectPeerID.NotFound"
+	ErrCodeInvalidTransitGatewayIDNotFound                = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound = "InvalidTransitGatewayMulticastDomainId.NotFound"
 )
 
 const (
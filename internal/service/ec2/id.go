@@ -71,6 +71,44 @@ func ClientVPNRouteParseID(id string) (string, string, string, error) {
 			"target-subnet-id"+clientVpnRouteIDSeparator+"destination-cidr-block", id)
 }
 
+const ipamPoolAllocationIDSeparator = "_"
+
+func IPAMPoolAllocationCreateID(allocationID, poolID string) string {
+	parts := []string{allocationID, poolID}
+	id := strings.Join(parts, ipamPoolAllocationIDSeparator)
+
+	return id
+}
+
+func IPAMPoolAllocationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ipamPoolAllocationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ipam-pool-allocation-id%[2]sipam-pool-id", id, ipamPoolAllocationIDSeparator)
+}
+
+const ipamPoolCIDRIDSeparator = "_"
+
+func IPAMPoolCIDRCreateID(cidrBlock, poolID string) string {
+	parts := []string{cidrBlock, poolID}
+	id := strings.Join(parts, ipamPoolCIDRIDSeparator)
+
+	return id
+}
+
+func IPAMPoolCIDRParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ipamPoolCIDRIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cidr-block%[2]sipam-pool-id", id, ipamPoolCIDRIDSeparator)
+}
+
 const managedPrefixListEntryIDSeparator = ","
 
 func ManagedPrefixListEntryCreateID(prefixListID, cidrBlock string) string {
@@ -94,6 +132,63 @@ func RouteCreateID(routeTableID, destination string) string {
 	return fmt.Sprintf("r-%s%d", routeTableID, create.StringHashcode(destination))
 }
 
+const transitGatewayMulticastDomainAssociationIDSeparator = "/"
+
+func TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID string) string {
+	parts := []string{multicastDomainID, attachmentID, subnetID}
+	id := strings.Join(parts, transitGatewayMulticastDomainAssociationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastDomainAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastDomainAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]stransit-gateway-attachment-id%[2]ssubnet-id", id, transitGatewayMulticastDomainAssociationIDSeparator)
+}
+
+const transitGatewayMulticastGroupMemberIDSeparator = "/"
+
+func TransitGatewayMulticastGroupMemberCreateID(multicastDomainID, groupIPAddress, eniID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, eniID}
+	id := strings.Join(parts, transitGatewayMulticastGroupMemberIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastGroupMemberParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupMemberIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sgroup-ip-address%[2]snetwork-interface-id", id, transitGatewayMulticastGroupMemberIDSeparator)
+}
+
+const transitGatewayMulticastGroupSourceIDSeparator = "/"
+
+func TransitGatewayMulticastGroupSourceCreateID(multicastDomainID, groupIPAddress, eniID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, eniID}
+	id := strings.Join(parts, transitGatewayMulticastGroupSourceIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastGroupSourceParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupSourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sgroup-ip-address%[2]snetwork-interface-id", id, transitGatewayMulticastGroupSourceIDSeparator)
+}
+
 const transitGatewayPrefixListReferenceSeparator = "_"
 
 func TransitGatewayPrefixListReferenceCreateID(transitGatewayRouteTableID string, prefixListID string) string {
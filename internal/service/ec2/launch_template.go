@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -113,7 +114,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 										Type:             schema.TypeString,
 										Optional:         true,
 										DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-										ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+										ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 									},
 									"encrypted": {
 										// Use TypeString to allow an "unspecified" value,
@@ -123,7 +124,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 										Type:             schema.TypeString,
 										Optional:         true,
 										DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-										ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+										ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 									},
 									"iops": {
 										Type:     schema.TypeInt,
@@ -237,7 +238,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-				ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+				ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 			},
 
 			"elastic_gpu_specifications": {
@@ -448,13 +449,13 @@ func ResourceLaunchTemplate() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-							ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 						},
 						"associate_public_ip_address": {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-							ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 						},
 						"delete_on_termination": {
 							// Use TypeString to allow an "unspecified" value,
@@ -464,7 +465,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-							ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 						},
 						"description": {
 							Type:     schema.TypeString,
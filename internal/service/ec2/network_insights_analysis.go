@@ -0,0 +1,595 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInsightsAnalysis() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInsightsAnalysisCreate,
+		Read:   resourceNetworkInsightsAnalysisRead,
+		Update: resourceNetworkInsightsAnalysisUpdate,
+		Delete: resourceNetworkInsightsAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(NetworkInsightsAnalysisCreatedTimeout),
+			Update: schema.DefaultTimeout(NetworkInsightsAnalysisCreatedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alternate_path_hints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"explanations": networkInsightsAnalysisExplanationsSchema(),
+			"filter_in_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"forward_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"network_insights_path_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"return_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkInsightsAnalysisCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(d.Get("network_insights_path_id").(string)),
+		TagSpecifications:     ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsAnalysis),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FilterInArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAnalysis(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Network Insights Analysis: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := WaitNetworkInsightsAnalysisCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindNetworkInsightsAnalysisByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	// The analysis results are deeply nested and mirror the API's structure.
+	tfMap, err := flex.FlattenWithSchema(output, ResourceNetworkInsightsAnalysis().Schema)
+
+	if err != nil {
+		return fmt.Errorf("error flattening EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("alternate_path_hints", tfMap["alternate_path_hints"]); err != nil {
+		return fmt.Errorf("error setting alternate_path_hints: %w", err)
+	}
+	d.Set("arn", output.NetworkInsightsAnalysisArn)
+	if err := d.Set("explanations", tfMap["explanations"]); err != nil {
+		return fmt.Errorf("error setting explanations: %w", err)
+	}
+	d.Set("filter_in_arns", aws.StringValueSlice(output.FilterInArns))
+	if err := d.Set("forward_path_components", tfMap["forward_path_components"]); err != nil {
+		return fmt.Errorf("error setting forward_path_components: %w", err)
+	}
+	d.Set("network_insights_path_id", output.NetworkInsightsPathId)
+	d.Set("path_found", output.NetworkPathFound)
+	if err := d.Set("return_path_components", tfMap["return_path_components"]); err != nil {
+		return fmt.Errorf("error setting return_path_components: %w", err)
+	}
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInsightsAnalysisUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Network Insights Analysis (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("wait_for_completion") && d.Get("wait_for_completion").(bool) {
+		if _, err := WaitNetworkInsightsAnalysisCreated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAnalysis(&ec2.DeleteNetworkInsightsAnalysisInput{
+		NetworkInsightsAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func networkInsightsAnalysisAnalysisComponentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisAnalysisAclRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"egress": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"port_range": networkInsightsAnalysisPortRangesSchema(),
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_action": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisAnalysisLoadBalancerListenerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"load_balancer_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisAnalysisLoadBalancerTargetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"availability_zone": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisAnalysisPacketHeaderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"destination_port_ranges": networkInsightsAnalysisPortRangesSchema(),
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"source_port_ranges": networkInsightsAnalysisPortRangesSchema(),
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisAnalysisRouteTableRouteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_prefix_list_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"egress_only_internet_gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"nat_gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_interface_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"origin": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"transit_gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vpc_peering_connection_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisAnalysisSecurityGroupRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"direction": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port_range": networkInsightsAnalysisPortRangesSchema(),
+				"prefix_list_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"security_group_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisExplanationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"acl":      networkInsightsAnalysisAnalysisComponentsSchema(),
+				"acl_rule": networkInsightsAnalysisAnalysisAclRuleSchema(),
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"attached_to": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"availability_zones": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cidrs": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"classic_load_balancer_listener": networkInsightsAnalysisAnalysisLoadBalancerListenerSchema(),
+				"component":                      networkInsightsAnalysisAnalysisComponentsSchema(),
+				"customer_gateway":               networkInsightsAnalysisAnalysisComponentsSchema(),
+				"destination":                    networkInsightsAnalysisAnalysisComponentsSchema(),
+				"destination_vpc":                networkInsightsAnalysisAnalysisComponentsSchema(),
+				"direction": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"elastic_load_balancer_listener": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"explanation_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ingress_route_table": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"internet_gateway":    networkInsightsAnalysisAnalysisComponentsSchema(),
+				"load_balancer_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"load_balancer_listener_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"load_balancer_target":        networkInsightsAnalysisAnalysisLoadBalancerTargetSchema(),
+				"load_balancer_target_group":  networkInsightsAnalysisAnalysisComponentsSchema(),
+				"load_balancer_target_groups": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"load_balancer_target_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"missing_component": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"nat_gateway":       networkInsightsAnalysisAnalysisComponentsSchema(),
+				"network_interface": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"packet_field": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"port_ranges": networkInsightsAnalysisPortRangesSchema(),
+				"prefix_list": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"protocols": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"route_table":         networkInsightsAnalysisAnalysisComponentsSchema(),
+				"route_table_route":   networkInsightsAnalysisAnalysisRouteTableRouteSchema(),
+				"security_group":      networkInsightsAnalysisAnalysisComponentsSchema(),
+				"security_group_rule": networkInsightsAnalysisAnalysisSecurityGroupRuleSchema(),
+				"security_groups":     networkInsightsAnalysisAnalysisComponentsSchema(),
+				"source_vpc":          networkInsightsAnalysisAnalysisComponentsSchema(),
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"subnet":                 networkInsightsAnalysisAnalysisComponentsSchema(),
+				"subnet_route_table":     networkInsightsAnalysisAnalysisComponentsSchema(),
+				"vpc":                    networkInsightsAnalysisAnalysisComponentsSchema(),
+				"vpc_endpoint":           networkInsightsAnalysisAnalysisComponentsSchema(),
+				"vpc_peering_connection": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"vpn_connection":         networkInsightsAnalysisAnalysisComponentsSchema(),
+				"vpn_gateway":            networkInsightsAnalysisAnalysisComponentsSchema(),
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisPathComponentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"acl_rule":            networkInsightsAnalysisAnalysisAclRuleSchema(),
+				"attached_to":         networkInsightsAnalysisAnalysisComponentsSchema(),
+				"component":           networkInsightsAnalysisAnalysisComponentsSchema(),
+				"destination_vpc":     networkInsightsAnalysisAnalysisComponentsSchema(),
+				"inbound_header":      networkInsightsAnalysisAnalysisPacketHeaderSchema(),
+				"outbound_header":     networkInsightsAnalysisAnalysisPacketHeaderSchema(),
+				"route_table_route":   networkInsightsAnalysisAnalysisRouteTableRouteSchema(),
+				"security_group_rule": networkInsightsAnalysisAnalysisSecurityGroupRuleSchema(),
+				"sequence_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"source_vpc": networkInsightsAnalysisAnalysisComponentsSchema(),
+				"subnet":     networkInsightsAnalysisAnalysisComponentsSchema(),
+				"vpc":        networkInsightsAnalysisAnalysisComponentsSchema(),
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisPortRangesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"to": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
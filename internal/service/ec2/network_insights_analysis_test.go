@@ -0,0 +1,351 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestNetworkInsightsAnalysisFlatten(t *testing.T) {
+	component := &ec2.AnalysisComponent{
+		Arn:  aws.String("arn:aws:ec2:us-west-2:123456789012:subnet/subnet-1"), //lintignore:AWSAT003,AWSAT005
+		Id:   aws.String("subnet-1"),
+		Name: aws.String("test"),
+	}
+	portRange := &ec2.PortRange{
+		From: aws.Int64(80),
+		To:   aws.Int64(443),
+	}
+	apiObject := &ec2.NetworkInsightsAnalysis{
+		AlternatePathHints: []*ec2.AlternatePathHint{{
+			ComponentArn: component.Arn,
+			ComponentId:  component.Id,
+		}},
+		Explanations: []*ec2.Explanation{{
+			AclRule: &ec2.AnalysisAclRule{
+				Cidr:       aws.String("0.0.0.0/0"),
+				Egress:     aws.Bool(false),
+				PortRange:  portRange,
+				RuleNumber: aws.Int64(100),
+			},
+			Cidrs:           aws.StringSlice([]string{"10.0.0.0/16"}),
+			ExplanationCode: aws.String("ENI_SG_RULES_MISMATCH"),
+			LoadBalancerTarget: &ec2.AnalysisLoadBalancerTarget{
+				Instance: component,
+				Port:     aws.Int64(80),
+			},
+			PortRanges:     []*ec2.PortRange{portRange},
+			SecurityGroups: []*ec2.AnalysisComponent{component},
+			Subnet:         component,
+		}},
+		ForwardPathComponents: []*ec2.PathComponent{{
+			Component: component,
+			InboundHeader: &ec2.AnalysisPacketHeader{
+				DestinationAddresses:  aws.StringSlice([]string{"10.0.0.1"}),
+				DestinationPortRanges: []*ec2.PortRange{portRange},
+			},
+			SequenceNumber: aws.Int64(1),
+		}},
+		NetworkPathFound: aws.Bool(true),
+	}
+
+	r := tfec2.ResourceNetworkInsightsAnalysis()
+	tfMap, err := flex.FlattenWithSchema(apiObject, r.Schema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+
+	for _, k := range []string{"alternate_path_hints", "explanations", "forward_path_components", "return_path_components"} {
+		if err := d.Set(k, tfMap[k]); err != nil {
+			t.Fatalf("error setting %s: %s", k, err)
+		}
+	}
+
+	if got, expected := d.Get("explanations.0.load_balancer_target.0.instance.0.id").(string), "subnet-1"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := d.Get("forward_path_components.0.inbound_header.0.destination_port_ranges.0.to").(int), 443; got != expected {
+		t.Errorf("got %d, expected %d", got, expected)
+	}
+
+	if got := d.Get("return_path_components").([]interface{}); len(got) != 0 {
+		t.Errorf("expected no return path components, got %v", got)
+	}
+}
+
+func TestAccEC2NetworkInsightsAnalysis_basic(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	pathResourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-analysis/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "filter_in_arns.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_path_id", pathResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "forward_path_components.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "return_path_components.#"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", "succeeded"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_disappears(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInsightsAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_tags(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_filterInARNs(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfigFilterInARNs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_in_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "filter_in_arns.*", vpcResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfigWaitForCompletion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisConfigWaitForCompletion(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "succeeded"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAnalysisExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Analysis ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsAnalysisDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_network_insights_analysis" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Insights Analysis %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccNetworkInsightsAnalysisConfigBase(rName string) string {
+	return testAccNetworkInsightsPathConfig(rName, "tcp")
+}
+
+func testAccNetworkInsightsAnalysisConfig(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), `
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+}
+`)
+}
+
+func testAccNetworkInsightsAnalysisConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccNetworkInsightsAnalysisConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccNetworkInsightsAnalysisConfigFilterInARNs(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), `
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  filter_in_arns           = [aws_vpc.test.arn]
+}
+`)
+}
+
+func testAccNetworkInsightsAnalysisConfigWaitForCompletion(rName string, waitForCompletion bool) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = %[1]t
+}
+`, waitForCompletion))
+}
@@ -0,0 +1,286 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2NetworkInsightsPath_basic(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathConfig(rName, "tcp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-path/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "destination", "aws_network_interface.test.1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ip", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "tcp"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_network_interface.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_ip", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsPath_disappears(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathConfig(rName, "udp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInsightsPath(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsPath_tags(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInsightsPathConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsPathConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsPath_sourceIPDestinationIPAndPort(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsPathConfigSourceIPDestinationIPAndPort(rName, "1.1.1.1", "10.0.0.10", 443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_ip", "10.0.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "source_ip", "1.1.1.1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInsightsPathConfigSourceIPDestinationIPAndPort(rName, "8.8.8.8", "10.0.0.11", 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_ip", "10.0.0.11"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "source_ip", "8.8.8.8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsPathExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Path ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := tfec2.FindNetworkInsightsPathByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsPathDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_network_insights_path" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInsightsPathByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Insights Path %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccNetworkInsightsPathConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.0.0.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  count = 2
+
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccNetworkInsightsPathConfig(rName, protocol string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = %[1]q
+}
+`, protocol))
+}
+
+func testAccNetworkInsightsPathConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccNetworkInsightsPathConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccNetworkInsightsPathConfigSourceIPDestinationIPAndPort(rName, sourceIP, destinationIP string, destinationPort int) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathConfigBase(rName), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_path" "test" {
+  source           = aws_internet_gateway.test.id
+  source_ip        = %[2]q
+  destination      = aws_network_interface.test[0].id
+  destination_ip   = %[3]q
+  destination_port = %[4]d
+  protocol         = "tcp"
+}
+`, rName, sourceIP, destinationIP, destinationPort))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -97,6 +98,13 @@ func ResourceTransitGateway() *schema.Resource {
 					ec2.DnsSupportValueEnable,
 				}, false),
 			},
+			"multicast_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.MulticastSupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.MulticastSupportValue_Values(), false),
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -107,6 +115,15 @@ func ResourceTransitGateway() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -131,11 +148,16 @@ func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 			DefaultRouteTableAssociation: aws.String(d.Get("default_route_table_association").(string)),
 			DefaultRouteTablePropagation: aws.String(d.Get("default_route_table_propagation").(string)),
 			DnsSupport:                   aws.String(d.Get("dns_support").(string)),
+			MulticastSupport:             aws.String(d.Get("multicast_support").(string)),
 			VpnEcmpSupport:               aws.String(d.Get("vpn_ecmp_support").(string)),
 		},
 		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGateway),
 	}
 
+	if v, ok := d.GetOk("transit_gateway_cidr_blocks"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options.TransitGatewayCidrBlocks = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("amazon_side_asn"); ok {
 		input.Options.AmazonSideAsn = aws.Int64(int64(v.(int)))
 	}
@@ -200,6 +222,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("default_route_table_propagation", transitGateway.Options.DefaultRouteTablePropagation)
 	d.Set("description", transitGateway.Description)
 	d.Set("dns_support", transitGateway.Options.DnsSupport)
+	d.Set("multicast_support", transitGateway.Options.MulticastSupport)
 	d.Set("owner_id", transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
 
@@ -214,6 +237,10 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
+	if err := d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting transit_gateway_cidr_blocks: %w", err)
+	}
+
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	return nil
@@ -252,6 +279,22 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 		options.DnsSupport = aws.String(d.Get("dns_support").(string))
 	}
 
+	if d.HasChange("transit_gateway_cidr_blocks") {
+		transitGatewayModified = true
+
+		oldRaw, newRaw := d.GetChange("transit_gateway_cidr_blocks")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+
+		if added := newSet.Difference(oldSet); added.Len() > 0 {
+			options.AddTransitGatewayCidrBlocks = flex.ExpandStringSet(added)
+		}
+
+		if removed := oldSet.Difference(newSet); removed.Len() > 0 {
+			options.RemoveTransitGatewayCidrBlocks = flex.ExpandStringSet(removed)
+		}
+	}
+
 	if d.HasChange("vpn_ecmp_support") {
 		transitGatewayModified = true
 		options.VpnEcmpSupport = aws.String(d.Get("vpn_ecmp_support").(string))
@@ -0,0 +1,77 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayConnectDataSource_Filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectFilterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "protocol", dataSourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", dataSourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectDataSource_ID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectIDDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "protocol", dataSourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", dataSourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectFilterDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectConfig(rName), `
+data "aws_ec2_transit_gateway_connect" "test" {
+  filter {
+    name   = "transit-gateway-attachment-id"
+    values = [aws_ec2_transit_gateway_connect.test.id]
+  }
+}
+`)
+}
+
+func testAccTransitGatewayConnectIDDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectConfig(rName), `
+data "aws_ec2_transit_gateway_connect" "test" {
+  id = aws_ec2_transit_gateway_connect.test.id
+}
+`)
+}
@@ -0,0 +1,114 @@
+package ec2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceTransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayConnectPeerRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": CustomFiltersSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"peer_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_attachment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.TransitGatewayConnectPeerIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = BuildCustomFilterList(d.Get("filter").(*schema.Set))
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Filters = append(input.Filters, ec2TagFiltersFromMap(v)...)
+	}
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	transitGatewayConnectPeer, err := FindTransitGatewayConnectPeer(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("EC2 Transit Gateway Connect Peer", err)
+	}
+
+	d.SetId(aws.StringValue(transitGatewayConnectPeer.TransitGatewayConnectPeerId))
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("transit-gateway-connect-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("transit_gateway_attachment_id", transitGatewayConnectPeer.TransitGatewayAttachmentId)
+
+	if v := transitGatewayConnectPeer.ConnectPeerConfiguration; v != nil {
+		if len(v.BgpConfigurations) > 0 && v.BgpConfigurations[0] != nil {
+			d.Set("bgp_asn", strconv.FormatInt(aws.Int64Value(v.BgpConfigurations[0].PeerAsn), 10))
+		} else {
+			d.Set("bgp_asn", nil)
+		}
+		d.Set("inside_cidr_blocks", aws.StringValueSlice(v.InsideCidrBlocks))
+		d.Set("peer_address", v.PeerAddress)
+		d.Set("transit_gateway_address", v.TransitGatewayAddress)
+	} else {
+		d.Set("bgp_asn", nil)
+		d.Set("inside_cidr_blocks", nil)
+		d.Set("peer_address", nil)
+		d.Set("transit_gateway_address", nil)
+	}
+
+	if err := d.Set("tags", KeyValueTags(transitGatewayConnectPeer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayConnectPeerDataSource_Filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect_peer.test"
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectPeerFilterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "bgp_asn", dataSourceName, "bgp_asn"),
+					resource.TestCheckResourceAttrPair(resourceName, "inside_cidr_blocks.#", dataSourceName, "inside_cidr_blocks.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_address", dataSourceName, "peer_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_address", dataSourceName, "transit_gateway_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", dataSourceName, "transit_gateway_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectPeerDataSource_ID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect_peer.test"
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectPeerIDDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "bgp_asn", dataSourceName, "bgp_asn"),
+					resource.TestCheckResourceAttrPair(resourceName, "inside_cidr_blocks.#", dataSourceName, "inside_cidr_blocks.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_address", dataSourceName, "peer_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_address", dataSourceName, "transit_gateway_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", dataSourceName, "transit_gateway_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectPeerFilterDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectPeerConfig(rName), `
+data "aws_ec2_transit_gateway_connect_peer" "test" {
+  filter {
+    name   = "transit-gateway-connect-peer-id"
+    values = [aws_ec2_transit_gateway_connect_peer.test.id]
+  }
+}
+`)
+}
+
+func testAccTransitGatewayConnectPeerIDDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectPeerConfig(rName), `
+data "aws_ec2_transit_gateway_connect_peer" "test" {
+  id = aws_ec2_transit_gateway_connect_peer.test.id
+}
+`)
+}
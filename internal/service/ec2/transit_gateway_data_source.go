@@ -55,6 +55,10 @@ func DataSourceTransitGateway() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"multicast_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -64,6 +68,11 @@ func DataSourceTransitGateway() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,6 +128,7 @@ func dataSourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("default_route_table_propagation", transitGateway.Options.DefaultRouteTablePropagation)
 	d.Set("description", transitGateway.Description)
 	d.Set("dns_support", transitGateway.Options.DnsSupport)
+	d.Set("multicast_support", transitGateway.Options.MulticastSupport)
 	d.Set("owner_id", transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
 
@@ -126,6 +136,10 @@ func dataSourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
+	if err := d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting transit_gateway_cidr_blocks: %w", err)
+	}
+
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	d.SetId(aws.StringValue(transitGateway.TransitGatewayId))
@@ -10,6 +10,14 @@ import (
 
 func TestAccEC2TransitGatewayDataSource_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"Connect": {
+			"Filter": testAccTransitGatewayConnectDataSource_Filter,
+			"ID":     testAccTransitGatewayConnectDataSource_ID,
+		},
+		"ConnectPeer": {
+			"Filter": testAccTransitGatewayConnectPeerDataSource_Filter,
+			"ID":     testAccTransitGatewayConnectPeerDataSource_ID,
+		},
 		"DxGatewayAttachment": {
 			"Filter":                         testAccTransitGatewayDxGatewayAttachmentDataSource_filter,
 			"TransitGatewayIdAndDxGatewayId": testAccTransitGatewayDxGatewayAttachmentDataSource_TransitGatewayIdAndDxGatewayID,
@@ -18,6 +26,10 @@ func TestAccEC2TransitGatewayDataSource_serial(t *testing.T) {
 			"Filter": testAccTransitGatewayDataSource_Filter,
 			"ID":     testAccTransitGatewayDataSource_ID,
 		},
+		"MulticastDomain": {
+			"Filter": testAccTransitGatewayMulticastDomainDataSource_Filter,
+			"ID":     testAccTransitGatewayMulticastDomainDataSource_ID,
+		},
 		"PeeringAttachment": {
 			"FilterSameAccount":      testAccTransitGatewayPeeringAttachmentDataSource_Filter_sameAccount,
 			"FilterDifferentAccount": testAccTransitGatewayPeeringAttachmentDataSource_Filter_differentAccount,
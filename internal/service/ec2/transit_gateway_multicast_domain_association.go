@@ -0,0 +1,140 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTransitGatewayMulticastDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayMulticastDomainAssociationCreate,
+		Read:   resourceTransitGatewayMulticastDomainAssociationRead,
+		Delete: resourceTransitGatewayMulticastDomainAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TransitGatewayMulticastDomainAssociationCreatedTimeout),
+			Delete: schema.DefaultTimeout(TransitGatewayMulticastDomainAssociationDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayMulticastDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	attachmentID := d.Get("transit_gateway_attachment_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+	id := TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID)
+	input := &ec2.AssociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Domain Association: %s", input)
+	_, err := conn.AssociateTransitGatewayMulticastDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Domain Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitTransitGatewayMulticastDomainAssociationCreated(conn, multicastDomainID, attachmentID, subnetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceTransitGatewayMulticastDomainAssociationRead(d, meta)
+}
+
+func resourceTransitGatewayMulticastDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, attachmentID, subnetID, err := TransitGatewayMulticastDomainAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindTransitGatewayMulticastDomainAssociationByThreePartKey(conn, multicastDomainID, attachmentID, subnetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("subnet_id", association.Subnet.SubnetId)
+	d.Set("transit_gateway_attachment_id", association.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceTransitGatewayMulticastDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, attachmentID, subnetID, err := TransitGatewayMulticastDomainAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Multicast Domain Association: %s", d.Id())
+	_, err = conn.DisassociateTransitGatewayMulticastDomain(&ec2.DisassociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Domain Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitTransitGatewayMulticastDomainAssociationDeleted(conn, multicastDomainID, attachmentID, subnetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
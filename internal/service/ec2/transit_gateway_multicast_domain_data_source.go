@@ -0,0 +1,266 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceTransitGatewayMulticastDomain() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTransitGatewayMulticastDomainRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_attachment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"auto_accept_shared_associations": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": CustomFiltersSchema(),
+			"igmpv2_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_sources_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayMulticastDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayMulticastDomainsInput{}
+
+	if v, ok := d.GetOk("transit_gateway_multicast_domain_id"); ok {
+		input.TransitGatewayMulticastDomainIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = BuildCustomFilterList(d.Get("filter").(*schema.Set))
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Filters = append(input.Filters, ec2TagFiltersFromMap(v)...)
+	}
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	multicastDomain, err := FindTransitGatewayMulticastDomain(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("EC2 Transit Gateway Multicast Domain", err)
+	}
+
+	d.SetId(aws.StringValue(multicastDomain.TransitGatewayMulticastDomainId))
+	d.Set("arn", multicastDomain.TransitGatewayMulticastDomainArn)
+	if v := multicastDomain.Options; v != nil {
+		d.Set("auto_accept_shared_associations", v.AutoAcceptSharedAssociations)
+		d.Set("igmpv2_support", v.Igmpv2Support)
+		d.Set("static_sources_support", v.StaticSourcesSupport)
+	} else {
+		d.Set("auto_accept_shared_associations", nil)
+		d.Set("igmpv2_support", nil)
+		d.Set("static_sources_support", nil)
+	}
+	d.Set("owner_id", multicastDomain.OwnerId)
+	d.Set("state", multicastDomain.State)
+	d.Set("transit_gateway_id", multicastDomain.TransitGatewayId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomain.TransitGatewayMulticastDomainId)
+
+	if err := d.Set("tags", KeyValueTags(multicastDomain.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	associations, err := FindTransitGatewayMulticastDomainAssociations(conn, &ec2.GetTransitGatewayMulticastDomainAssociationsInput{
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) associations: %w", d.Id(), err)
+	}
+
+	if err := d.Set("associations", flattenTransitGatewayMulticastDomainAssociations(associations)); err != nil {
+		return fmt.Errorf("error setting associations: %w", err)
+	}
+
+	members, err := FindTransitGatewayMulticastGroups(conn, &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"is-group-member": "true",
+		}),
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) members: %w", d.Id(), err)
+	}
+
+	if err := d.Set("members", flattenTransitGatewayMulticastGroups(members)); err != nil {
+		return fmt.Errorf("error setting members: %w", err)
+	}
+
+	sources, err := FindTransitGatewayMulticastGroups(conn, &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"is-group-source": "true",
+		}),
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) sources: %w", d.Id(), err)
+	}
+
+	if err := d.Set("sources", flattenTransitGatewayMulticastGroups(sources)); err != nil {
+		return fmt.Errorf("error setting sources: %w", err)
+	}
+
+	return nil
+}
+
+func flattenTransitGatewayMulticastDomainAssociation(apiObject *ec2.TransitGatewayMulticastDomainAssociation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Subnet; v != nil {
+		tfMap["subnet_id"] = aws.StringValue(v.SubnetId)
+	}
+
+	if v := apiObject.TransitGatewayAttachmentId; v != nil {
+		tfMap["transit_gateway_attachment_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTransitGatewayMulticastDomainAssociations(apiObjects []*ec2.TransitGatewayMulticastDomainAssociation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitGatewayMulticastDomainAssociation(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTransitGatewayMulticastGroup(apiObject *ec2.TransitGatewayMulticastGroup) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GroupIpAddress; v != nil {
+		tfMap["group_ip_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.NetworkInterfaceId; v != nil {
+		tfMap["network_interface_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTransitGatewayMulticastGroups(apiObjects []*ec2.TransitGatewayMulticastGroup) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitGatewayMulticastGroup(apiObject))
+	}
+
+	return tfList
+}
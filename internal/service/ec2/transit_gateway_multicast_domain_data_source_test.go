@@ -0,0 +1,93 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTransitGatewayMulticastDomainDataSource_Filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_multicast_domain.test"
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainFilterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "associations.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_accept_shared_associations", dataSourceName, "auto_accept_shared_associations"),
+					resource.TestCheckResourceAttrPair(resourceName, "igmpv2_support", dataSourceName, "igmpv2_support"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_id", dataSourceName, "owner_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "sources.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state"),
+					resource.TestCheckResourceAttrPair(resourceName, "static_sources_support", dataSourceName, "static_sources_support"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "transit_gateway_multicast_domain_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayMulticastDomainDataSource_ID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_multicast_domain.test"
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainIDDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "associations.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_accept_shared_associations", dataSourceName, "auto_accept_shared_associations"),
+					resource.TestCheckResourceAttrPair(resourceName, "igmpv2_support", dataSourceName, "igmpv2_support"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_id", dataSourceName, "owner_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "sources.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state"),
+					resource.TestCheckResourceAttrPair(resourceName, "static_sources_support", dataSourceName, "static_sources_support"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "transit_gateway_multicast_domain_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayMulticastDomainFilterDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayMulticastDomainConfig(rName), `
+data "aws_ec2_transit_gateway_multicast_domain" "test" {
+  filter {
+    name   = "transit-gateway-multicast-domain-id"
+    values = [aws_ec2_transit_gateway_multicast_domain.test.id]
+  }
+}
+`)
+}
+
+func testAccTransitGatewayMulticastDomainIDDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayMulticastGroupMemberConfig(rName), `
+data "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_multicast_domain_id = aws_ec2_transit_gateway_multicast_group_member.test.transit_gateway_multicast_domain_id
+}
+`)
+}
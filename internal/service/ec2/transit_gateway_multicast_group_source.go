@@ -0,0 +1,135 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTransitGatewayMulticastGroupSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayMulticastGroupSourceCreate,
+		Read:   resourceTransitGatewayMulticastGroupSourceRead,
+		Delete: resourceTransitGatewayMulticastGroupSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayMulticastGroupSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	groupIPAddress := d.Get("group_ip_address").(string)
+	eniID := d.Get("network_interface_id").(string)
+	id := TransitGatewayMulticastGroupSourceCreateID(multicastDomainID, groupIPAddress, eniID)
+	input := &ec2.RegisterTransitGatewayMulticastGroupSourcesInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{eniID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Group Source: %s", input)
+	_, err := conn.RegisterTransitGatewayMulticastGroupSources(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Group Source (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindTransitGatewayMulticastGroupSourceByThreePartKey(conn, multicastDomainID, groupIPAddress, eniID)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Group Source (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceTransitGatewayMulticastGroupSourceRead(d, meta)
+}
+
+func resourceTransitGatewayMulticastGroupSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, groupIPAddress, eniID, err := TransitGatewayMulticastGroupSourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	multicastGroup, err := FindTransitGatewayMulticastGroupSourceByThreePartKey(conn, multicastDomainID, groupIPAddress, eniID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Group Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Group Source (%s): %w", d.Id(), err)
+	}
+
+	d.Set("group_ip_address", multicastGroup.GroupIpAddress)
+	d.Set("network_interface_id", multicastGroup.NetworkInterfaceId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceTransitGatewayMulticastGroupSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, groupIPAddress, eniID, err := TransitGatewayMulticastGroupSourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Multicast Group Source: %s", d.Id())
+	_, err = conn.DeregisterTransitGatewayMulticastGroupSources(&ec2.DeregisterTransitGatewayMulticastGroupSourcesInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{eniID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Group Source (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
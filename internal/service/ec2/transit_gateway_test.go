@@ -18,6 +18,18 @@ import (
 
 func TestAccEC2TransitGateway_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"Connect": {
+			"basic":      testAccTransitGatewayConnect_basic,
+			"disappears": testAccTransitGatewayConnect_disappears,
+			"Tags":       testAccTransitGatewayConnect_Tags,
+			"TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled": testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled,
+		},
+		"ConnectPeer": {
+			"basic":      testAccTransitGatewayConnectPeer_basic,
+			"disappears": testAccTransitGatewayConnectPeer_disappears,
+			"BgpAsn":     testAccTransitGatewayConnectPeer_BgpAsn,
+			"Tags":       testAccTransitGatewayConnectPeer_Tags,
+		},
 		"Gateway": {
 			"basic":                       testAccTransitGateway_basic,
 			"disappears":                  testAccTransitGateway_disappears,
@@ -28,9 +40,29 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"DefaultRouteTablePropagation":                       testAccTransitGateway_DefaultRouteTablePropagation,
 			"Description":                                        testAccTransitGateway_Description,
 			"DnsSupport":                                         testAccTransitGateway_DNSSupport,
+			"MulticastSupport":                                   testAccTransitGateway_MulticastSupport,
 			"Tags":                                               testAccTransitGateway_Tags,
+			"TransitGatewayCidrBlocks":                           testAccTransitGateway_TransitGatewayCIDRBlocks,
 			"VpnEcmpSupport":                                     testAccTransitGateway_VPNECMPSupport,
 		},
+		"MulticastDomain": {
+			"basic":         testAccTransitGatewayMulticastDomain_basic,
+			"disappears":    testAccTransitGatewayMulticastDomain_disappears,
+			"Tags":          testAccTransitGatewayMulticastDomain_Tags,
+			"IGMPv2Support": testAccTransitGatewayMulticastDomain_IGMPv2Support,
+		},
+		"MulticastDomainAssociation": {
+			"basic":      testAccTransitGatewayMulticastDomainAssociation_basic,
+			"disappears": testAccTransitGatewayMulticastDomainAssociation_disappears,
+		},
+		"MulticastGroupMember": {
+			"basic":      testAccTransitGatewayMulticastGroupMember_basic,
+			"disappears": testAccTransitGatewayMulticastGroupMember_disappears,
+		},
+		"MulticastGroupSource": {
+			"basic":      testAccTransitGatewayMulticastGroupSource_basic,
+			"disappears": testAccTransitGatewayMulticastGroupSource_disappears,
+		},
 		"PeeringAttachment": {
 			"basic":            testAccTransitGatewayPeeringAttachment_basic,
 			"disappears":       testAccTransitGatewayPeeringAttachment_disappears,
@@ -440,6 +472,77 @@ func testAccTransitGateway_Description(t *testing.T) {
 	})
 }
 
+func testAccTransitGateway_MulticastSupport(t *testing.T) {
+	var transitGateway1, transitGateway2 ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastSupportConfig(ec2.MulticastSupportValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &transitGateway1),
+					resource.TestCheckResourceAttr(resourceName, "multicast_support", ec2.MulticastSupportValueEnable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayMulticastSupportConfig(ec2.MulticastSupportValueDisable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &transitGateway2),
+					testAccCheckTransitGatewayRecreated(&transitGateway1, &transitGateway2),
+					resource.TestCheckResourceAttr(resourceName, "multicast_support", ec2.MulticastSupportValueDisable),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGateway_TransitGatewayCIDRBlocks(t *testing.T) {
+	var transitGateway1, transitGateway2 ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayTransitGatewayCIDRBlocksConfig1("10.120.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &transitGateway1),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.120.0.0/24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayTransitGatewayCIDRBlocksConfig2("10.120.0.0/24", "10.121.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &transitGateway2),
+					testAccCheckTransitGatewayNotRecreated(&transitGateway1, &transitGateway2),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.120.0.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.121.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGateway_Tags(t *testing.T) {
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -738,6 +841,30 @@ resource "aws_ec2_transit_gateway" "test" {
 `, description)
 }
 
+func testAccTransitGatewayMulticastSupportConfig(multicastSupport string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  multicast_support = %q
+}
+`, multicastSupport)
+}
+
+func testAccTransitGatewayTransitGatewayCIDRBlocksConfig1(cidrBlock1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = [%[1]q]
+}
+`, cidrBlock1)
+}
+
+func testAccTransitGatewayTransitGatewayCIDRBlocksConfig2(cidrBlock1, cidrBlock2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = [%[1]q, %[2]q]
+}
+`, cidrBlock1, cidrBlock2)
+}
+
 func testAccTransitGatewayTags1Config(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -0,0 +1,297 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCIPAM() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCIPAMCreate,
+		Read:   resourceVPCIPAMRead,
+		Update: resourceVPCIPAMUpdate,
+		Delete: resourceVPCIPAMDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(IPAMCreateTimeout),
+			Update: schema.DefaultTimeout(IPAMUpdateTimeout),
+			Delete: schema.DefaultTimeout(IPAMDeleteTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"operating_regions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"private_default_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_default_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceVPCIPAMCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateIpamInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		OperatingRegions: expandIPAMOperatingRegions(d.Get("operating_regions").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.TagSpecifications = ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpam)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM: %s", input)
+	output, err := conn.CreateIpam(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Ipam.IpamId))
+
+	if _, err := WaitIPAMCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceVPCIPAMRead(d, meta)
+}
+
+func resourceVPCIPAMRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ipam, err := FindIPAMByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", ipam.IpamArn)
+	d.Set("description", ipam.Description)
+	if err := d.Set("operating_regions", flattenIPAMOperatingRegions(ipam.OperatingRegions)); err != nil {
+		return fmt.Errorf("error setting operating_regions: %w", err)
+	}
+	d.Set("private_default_scope_id", ipam.PrivateDefaultScopeId)
+	d.Set("public_default_scope_id", ipam.PublicDefaultScopeId)
+	d.Set("scope_count", ipam.ScopeCount)
+
+	tags := KeyValueTags(ipam.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCIPAMUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyIpamInput{
+			IpamId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("operating_regions") {
+			o, n := d.GetChange("operating_regions")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if v := ns.Difference(os).List(); len(v) > 0 {
+				input.AddOperatingRegions = expandIPAMOperatingRegions(v)
+			}
+
+			if v := os.Difference(ns).List(); len(v) > 0 {
+				for _, tfMapRaw := range v {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					input.RemoveOperatingRegions = append(input.RemoveOperatingRegions, &ec2.RemoveIpamOperatingRegion{
+						RegionName: aws.String(tfMap["region_name"].(string)),
+					})
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC IPAM: %s", input)
+		_, err := conn.ModifyIpam(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitIPAMUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC IPAM (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceVPCIPAMRead(d, meta)
+}
+
+func resourceVPCIPAMDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM: %s", d.Id())
+	_, err := conn.DeleteIpam(&ec2.DeleteIpamInput{
+		IpamId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidIPAMIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitIPAMDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIPAMOperatingRegions(tfList []interface{}) []*ec2.AddIpamOperatingRegion {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ec2.AddIpamOperatingRegion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ec2.AddIpamOperatingRegion{}
+
+		if v, ok := tfMap["region_name"].(string); ok && v != "" {
+			apiObject.RegionName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIPAMOperatingRegions(apiObjects []*ec2.IpamOperatingRegion) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"region_name": aws.StringValue(apiObject.RegionName),
+		})
+	}
+
+	return tfList
+}
+
+// ipamResourceIDFromARN returns the resource ID from an IPAM, IPAM scope or IPAM pool ARN,
+// e.g. arn:aws:ec2::123456789012:ipam-scope/ipam-scope-0123456789abcdef0.
+func ipamResourceIDFromARN(v, resourceType string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing EC2 VPC IPAM ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 2 || parts[0] != resourceType || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for EC2 VPC IPAM ARN resource (%[1]s), expected %[2]s/%[2]s-id", parsedARN.Resource, resourceType)
+	}
+
+	return parts[1], nil
+}
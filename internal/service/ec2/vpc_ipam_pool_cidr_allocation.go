@@ -0,0 +1,191 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCIPAMPoolCIDRAllocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCIPAMPoolCIDRAllocationCreate,
+		Read:   resourceVPCIPAMPoolCIDRAllocationRead,
+		Delete: resourceVPCIPAMPoolCIDRAllocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidCIDRNetworkAddress,
+				ConflictsWith: []string{"netmask_length"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disallowed_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"ipam_pool_allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(0, 128),
+				ConflictsWith: []string{"cidr"},
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVPCIPAMPoolCIDRAllocationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.AllocateIpamPoolCidrInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		IpamPoolId:  aws.String(poolID),
+	}
+
+	if v, ok := d.GetOk("cidr"); ok {
+		input.Cidr = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disallowed_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.DisallowedCidrs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Pool CIDR Allocation: %s", input)
+	output, err := conn.AllocateIpamPoolCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Pool (%s) CIDR Allocation: %w", poolID, err)
+	}
+
+	d.SetId(IPAMPoolAllocationCreateID(aws.StringValue(output.IpamPoolAllocation.IpamPoolAllocationId), poolID))
+
+	return resourceVPCIPAMPoolCIDRAllocationRead(d, meta)
+}
+
+func resourceVPCIPAMPoolCIDRAllocationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	allocationID, poolID, err := IPAMPoolAllocationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	allocation, err := FindIPAMPoolAllocationByTwoPartKey(conn, allocationID, poolID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Pool CIDR Allocation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Pool CIDR Allocation (%s): %w", d.Id(), err)
+	}
+
+	cidrBlock := aws.StringValue(allocation.Cidr)
+	d.Set("cidr", cidrBlock)
+	d.Set("description", allocation.Description)
+	d.Set("ipam_pool_allocation_id", allocationID)
+	d.Set("ipam_pool_id", poolID)
+	if parts := strings.Split(cidrBlock, "/"); len(parts) == 2 {
+		if v, err := strconv.Atoi(parts[1]); err == nil {
+			d.Set("netmask_length", v)
+		}
+	}
+	d.Set("resource_id", allocation.ResourceId)
+	d.Set("resource_owner", allocation.ResourceOwner)
+	d.Set("resource_type", allocation.ResourceType)
+
+	return nil
+}
+
+func resourceVPCIPAMPoolCIDRAllocationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	allocationID, poolID, err := IPAMPoolAllocationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting EC2 VPC IPAM Pool CIDR Allocation: %s", d.Id())
+	output, err := conn.ReleaseIpamPoolAllocation(&ec2.ReleaseIpamPoolAllocationInput{
+		Cidr:                 aws.String(d.Get("cidr").(string)),
+		IpamPoolAllocationId: aws.String(allocationID),
+		IpamPoolId:           aws.String(poolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidIPAMPoolAllocationIdNotFound, ErrCodeInvalidIPAMPoolIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Pool CIDR Allocation (%s): %w", d.Id(), err)
+	}
+
+	if output != nil && !aws.BoolValue(output.Success) {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Pool CIDR Allocation (%s): release unsuccessful", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2VPCIPAMPoolCIDRAllocation_basic(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRAllocationConfig("10.0.0.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRAllocationExists(resourceName, &allocation),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.0.0.0/28"),
+					resource.TestMatchResourceAttr(resourceName, "ipam_pool_allocation_id", regexp.MustCompile(`^ipam-pool-alloc-[\da-f]+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "28"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "custom"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAMPoolCIDRAllocation_disappears(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRAllocationConfig("10.0.0.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRAllocationExists(resourceName, &allocation),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAMPoolCIDRAllocation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAMPoolCIDRAllocation_netmaskLength(t *testing.T) {
+	var allocation ec2.IpamPoolAllocation
+	resourceName := "aws_vpc_ipam_pool_cidr_allocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRAllocationNetmaskLengthConfig(28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRAllocationExists(resourceName, &allocation),
+					resource.TestMatchResourceAttr(resourceName, "cidr", regexp.MustCompile(`^10\.0\.\d+\.\d+/28$`)),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "28"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disallowed_cidrs"},
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMPoolCIDRAllocationExists(n string, v *ec2.IpamPoolAllocation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool CIDR Allocation ID is set")
+		}
+
+		allocationID, poolID, err := tfec2.IPAMPoolAllocationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindIPAMPoolAllocationByTwoPartKey(conn, allocationID, poolID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMPoolCIDRAllocationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool_cidr_allocation" {
+			continue
+		}
+
+		allocationID, poolID, err := tfec2.IPAMPoolAllocationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.FindIPAMPoolAllocationByTwoPartKey(conn, allocationID, poolID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool CIDR Allocation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMPoolCIDRAllocationConfig(cidr string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, cidr))
+}
+
+func testAccVPCIPAMPoolCIDRAllocationNetmaskLengthConfig(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id     = aws_vpc_ipam_pool.test.id
+  netmask_length   = %[1]d
+  disallowed_cidrs = ["10.0.0.0/28"]
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength))
+}
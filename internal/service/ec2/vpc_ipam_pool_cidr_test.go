@@ -0,0 +1,133 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2VPCIPAMPoolCIDR_basic(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRExists(resourceName, &cidr),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAMPoolCIDR_disappears(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRExists(resourceName, &cidr),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAMPoolCIDR(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMPoolCIDRExists(n string, v *ec2.IpamPoolCidr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool CIDR ID is set")
+		}
+
+		cidrBlock, poolID, err := tfec2.IPAMPoolCIDRParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindIPAMPoolCIDRByTwoPartKey(conn, cidrBlock, poolID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMPoolCIDRDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool_cidr" {
+			continue
+		}
+
+		cidrBlock, poolID, err := tfec2.IPAMPoolCIDRParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.FindIPAMPoolCIDRByTwoPartKey(conn, cidrBlock, poolID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool CIDR %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMPoolCIDRConfig(cidr string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolConfig(), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+}
+`, cidr))
+}
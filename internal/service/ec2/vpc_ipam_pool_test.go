@@ -0,0 +1,281 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2VPCIPAMPool_basic(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+	ipamName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "ipv4"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "0"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam-pool/ipam-pool-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_scope_id", ipamName, "private_default_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", "private"),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "create-complete"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMPoolUpdatesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "32"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_max_netmask_length", "32"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_min_netmask_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.test", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAMPool_disappears(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAMPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAMPool_sourcePool(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.child"
+	parentName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolSourcePoolConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttrPair(resourceName, "locale", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_ipam_pool_id", parentName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAMPool_tags(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolTags1Config("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMPoolTags2Config("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCIPAMPoolTags1Config("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMPoolExists(n string, v *ec2.IpamPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindIPAMPoolByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMPoolDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool" {
+			continue
+		}
+
+		_, err := tfec2.FindIPAMPoolByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMPoolConfig() string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig("test"), `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+}
+`)
+}
+
+func testAccVPCIPAMPoolUpdatesConfig() string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig("test"), `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family                    = "ipv4"
+  allocation_default_netmask_length = 32
+  allocation_max_netmask_length     = 32
+  allocation_min_netmask_length     = 24
+  auto_import                       = true
+  description                       = "test"
+  ipam_scope_id                     = aws_vpc_ipam.test.private_default_scope_id
+
+  allocation_resource_tags = {
+    test = "1"
+  }
+}
+`)
+}
+
+func testAccVPCIPAMPoolSourcePoolConfig() string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolConfig(), `
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "10.0.0.0/16"
+}
+
+resource "aws_vpc_ipam_pool" "child" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`)
+}
+
+func testAccVPCIPAMPoolTags1Config(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig("test"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCIPAMPoolTags2Config(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig("test"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,271 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// IPAM is limited to a single instance per account per region by default,
+// so the IPAM acceptance tests are run serially.
+
+func TestAccEC2VPCIPAM_basic(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMConfig("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam/ipam-[\da-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "private_default_scope_id", regexp.MustCompile(`^ipam-scope-[\da-f]+`)),
+					resource.TestMatchResourceAttr(resourceName, "public_default_scope_id", regexp.MustCompile(`^ipam-scope-[\da-f]+`)),
+					resource.TestCheckResourceAttr(resourceName, "scope_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMConfig("test updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "description", "test updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAM_disappears(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMConfig("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAM(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAM_operatingRegions(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMOperatingRegionsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				Config: testAccVPCIPAMConfig("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2VPCIPAM_tags(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMTags1Config("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMTags2Config("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCIPAMTags1Config("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMExists(n string, v *ec2.Ipam) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindIPAMByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam" {
+			continue
+		}
+
+		_, err := tfec2.FindIPAMByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMConfig(description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = %[1]q
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+`, description)
+}
+
+func testAccVPCIPAMOperatingRegionsConfig() string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  operating_regions {
+    region_name = %[1]q
+  }
+}
+`, acctest.AlternateRegion())
+}
+
+func testAccVPCIPAMTags1Config(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccVPCIPAMTags2Config(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
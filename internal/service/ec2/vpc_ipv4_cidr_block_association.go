@@ -36,9 +36,26 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 
 			"cidr_block": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IsCIDRNetwork(16, 28), // The allowed block size is between a /28 netmask and /16 netmask.
+				AtLeastOneOf: []string{"cidr_block", "ipv4_ipam_pool_id"},
+			},
+
+			"ipv4_ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"ipv4_netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(16, 28),
+				ConflictsWith: []string{"cidr_block"},
+				RequiredWith:  []string{"ipv4_ipam_pool_id"},
 			},
 		},
 
@@ -53,9 +70,21 @@ func resourceVPCIPv4CIDRBlockAssociationCreate(d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	req := &ec2.AssociateVpcCidrBlockInput{
-		VpcId:     aws.String(d.Get("vpc_id").(string)),
-		CidrBlock: aws.String(d.Get("cidr_block").(string)),
+		VpcId: aws.String(d.Get("vpc_id").(string)),
 	}
+
+	if v, ok := d.GetOk("cidr_block"); ok {
+		req.CidrBlock = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_ipam_pool_id"); ok {
+		req.Ipv4IpamPoolId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_netmask_length"); ok {
+		req.Ipv4NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
 	log.Printf("[DEBUG] Creating VPC IPv4 CIDR block association: %#v", req)
 	resp, err := conn.AssociateVpcCidrBlock(req)
 	if err != nil {
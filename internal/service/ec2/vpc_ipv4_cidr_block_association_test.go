@@ -2,6 +2,7 @@ package ec2_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -45,6 +46,35 @@ func TestAccEC2VPCIPv4CIDRBlockAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2VPCIPv4CIDRBlockAssociation_ipamNetmaskLength(t *testing.T) {
+	var association ec2.VpcCidrBlockAssociation
+	resourceName := "aws_vpc_ipv4_cidr_block_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPv4CIDRBlockAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPv4CIDRBlockAssociationIPAMNetmaskLengthConfig(28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPv4CIDRBlockAssociationExists(resourceName, &association),
+					resource.TestMatchResourceAttr(resourceName, "cidr_block", regexp.MustCompile(`^10\.0\.\d+\.\d+/28$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_netmask_length", "28"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_ipam_pool_id", "ipv4_netmask_length"},
+			},
+		},
+	})
+}
+
 func testAccCheckAdditionalVPCIPv4CIDRBlock(association *ec2.VpcCidrBlockAssociation, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		CIDRBlock := association.CidrBlock
@@ -153,3 +183,19 @@ resource "aws_vpc_ipv4_cidr_block_association" "tertiary_cidr" {
   cidr_block = "170.2.0.0/16"
 }
 `
+
+func testAccVPCIPv4CIDRBlockAssociationIPAMNetmaskLengthConfig(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpc_ipv4_cidr_block_association" "test" {
+  vpc_id              = aws_vpc.test.id
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  ipv4_netmask_length = %[1]d
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength))
+}
@@ -794,6 +794,64 @@ func testAccCheckVpcDisappears(vpc *ec2.Vpc) resource.TestCheckFunc {
 }
 
 // https://github.com/hashicorp/terraform/issues/1301
+func TestAccEC2VPC_ipamIPv4NetmaskLength(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIPAMIPv4NetmaskLengthConfig(24),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(resourceName, &vpc),
+					resource.TestMatchResourceAttr(resourceName, "cidr_block", regexp.MustCompile(`^10\.0\.\d+\.0/24$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_netmask_length", "24"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_ipam_pool_id", "ipv4_netmask_length"},
+			},
+		},
+	})
+}
+
+func TestAccEC2VPC_ipamIPv4CIDRBlock(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIPAMIPv4CIDRBlockConfig("10.0.1.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(resourceName, &vpc),
+					testAccCheckVpcCidr(&vpc, "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipv4_ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_ipam_pool_id"},
+			},
+		},
+	})
+}
+
 func TestAccEC2VPC_bothDNSOptionsSet(t *testing.T) {
 	var vpc ec2.Vpc
 	resourceName := "aws_vpc.test"
@@ -1053,3 +1111,25 @@ resource "aws_vpc" "test" {
   }
 }
 `
+
+func testAccVpcIPAMIPv4NetmaskLengthConfig(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  ipv4_netmask_length = %[1]d
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, netmaskLength))
+}
+
+func testAccVpcIPAMIPv4CIDRBlockConfig(cidr string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolCIDRConfig("10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block        = %[1]q
+  ipv4_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, cidr))
+}
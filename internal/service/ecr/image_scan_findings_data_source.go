@@ -0,0 +1,340 @@
+package ecr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceImageScanFindings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceImageScanFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enhanced_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remediation_recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"finding_severity_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vulnerability_source_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceImageScanFindingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	imageID := &ecr.ImageIdentifier{}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		imageID.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		imageID.ImageTag = aws.String(v.(string))
+	}
+
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId:        imageID,
+		RepositoryName: aws.String(d.Get("repository_name").(string)),
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	var output *ecr.DescribeImageScanFindingsOutput
+	var enhancedFindings []*ecr.EnhancedImageScanFinding
+	var findings []*ecr.ImageScanFinding
+
+	log.Printf("[DEBUG] Reading ECR Image Scan Findings: %s", input)
+	err := conn.DescribeImageScanFindingsPages(input, func(page *ecr.DescribeImageScanFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = page
+
+		if v := page.ImageScanFindings; v != nil {
+			enhancedFindings = append(enhancedFindings, v.EnhancedFindings...)
+			findings = append(findings, v.Findings...)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Image Scan Findings: %w", err)
+	}
+
+	if output == nil || output.ImageId == nil {
+		return fmt.Errorf("error reading ECR Image Scan Findings: empty result")
+	}
+
+	d.SetId(aws.StringValue(output.ImageId.ImageDigest))
+	d.Set("image_digest", output.ImageId.ImageDigest)
+	d.Set("registry_id", output.RegistryId)
+	d.Set("repository_name", output.RepositoryName)
+
+	if v := output.ImageScanStatus; v != nil {
+		d.Set("image_scan_status", v.Status)
+		d.Set("image_scan_status_description", v.Description)
+	} else {
+		d.Set("image_scan_status", nil)
+		d.Set("image_scan_status_description", nil)
+	}
+
+	if v := output.ImageScanFindings; v != nil {
+		d.Set("finding_severity_counts", aws.Int64ValueMap(v.FindingSeverityCounts))
+		d.Set("image_scan_completed_at", flattenTimestamp(v.ImageScanCompletedAt))
+		d.Set("vulnerability_source_updated_at", flattenTimestamp(v.VulnerabilitySourceUpdatedAt))
+	} else {
+		d.Set("finding_severity_counts", nil)
+		d.Set("image_scan_completed_at", nil)
+		d.Set("vulnerability_source_updated_at", nil)
+	}
+
+	if err := d.Set("enhanced_findings", flattenEnhancedImageScanFindings(enhancedFindings)); err != nil {
+		return fmt.Errorf("error setting enhanced_findings: %w", err)
+	}
+
+	if err := d.Set("findings", flattenImageScanFindings(findings)); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+
+	return nil
+}
+
+func flattenEnhancedImageScanFinding(apiObject *ecr.EnhancedImageScanFinding) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id":    aws.StringValue(apiObject.AwsAccountId),
+		"description":       aws.StringValue(apiObject.Description),
+		"finding_arn":       aws.StringValue(apiObject.FindingArn),
+		"first_observed_at": flattenTimestamp(apiObject.FirstObservedAt),
+		"last_observed_at":  flattenTimestamp(apiObject.LastObservedAt),
+		"score":             aws.Float64Value(apiObject.Score),
+		"severity":          aws.StringValue(apiObject.Severity),
+		"status":            aws.StringValue(apiObject.Status),
+		"title":             aws.StringValue(apiObject.Title),
+		"type":              aws.StringValue(apiObject.Type),
+		"updated_at":        flattenTimestamp(apiObject.UpdatedAt),
+	}
+
+	if v := apiObject.PackageVulnerabilityDetails; v != nil {
+		tfMap["vulnerability_id"] = aws.StringValue(v.VulnerabilityId)
+	}
+
+	if v := apiObject.Remediation; v != nil && v.Recommendation != nil {
+		tfMap["remediation_recommendation"] = aws.StringValue(v.Recommendation.Text)
+	}
+
+	return tfMap
+}
+
+func flattenEnhancedImageScanFindings(apiObjects []*ecr.EnhancedImageScanFinding) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenEnhancedImageScanFinding(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenImageScanFinding(apiObject *ecr.ImageScanFinding) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"description": aws.StringValue(apiObject.Description),
+		"name":        aws.StringValue(apiObject.Name),
+		"severity":    aws.StringValue(apiObject.Severity),
+		"uri":         aws.StringValue(apiObject.Uri),
+	}
+
+	if len(apiObject.Attributes) > 0 {
+		attributes := make(map[string]interface{}, len(apiObject.Attributes))
+
+		for _, attribute := range apiObject.Attributes {
+			if attribute == nil {
+				continue
+			}
+
+			attributes[aws.StringValue(attribute.Key)] = aws.StringValue(attribute.Value)
+		}
+
+		tfMap["attributes"] = attributes
+	}
+
+	return tfMap
+}
+
+func flattenImageScanFindings(apiObjects []*ecr.ImageScanFinding) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenImageScanFinding(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTimestamp(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+
+	return aws.TimeValue(v).Format(time.RFC3339)
+}
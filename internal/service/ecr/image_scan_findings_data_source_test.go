@@ -0,0 +1,48 @@
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRImageScanFindingsDataSource_basic(t *testing.T) {
+	registry, repo, tag := "137112412989", "amazonlinux", "latest"
+	dataSourceName := "data.aws_ecr_image_scan_findings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageScanFindingsDataSourceConfig(registry, repo, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_digest", "data.aws_ecr_image.test", "image_digest"),
+					resource.TestCheckResourceAttr(dataSourceName, "registry_id", registry),
+					resource.TestCheckResourceAttr(dataSourceName, "repository_name", repo),
+					resource.TestCheckResourceAttrSet(dataSourceName, "image_scan_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageScanFindingsDataSourceConfig(reg, repo, tag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  image_tag       = %[3]q
+}
+
+data "aws_ecr_image_scan_findings" "test" {
+  registry_id     = data.aws_ecr_image.test.registry_id
+  repository_name = data.aws_ecr_image.test.repository_name
+  image_digest    = data.aws_ecr_image.test.image_digest
+}
+`, reg, repo, tag)
+}
@@ -0,0 +1,227 @@
+package ecr
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceRegistryScanningConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRegistryScanningConfigurationPut,
+		Read:   resourceRegistryScanningConfigurationRead,
+		Update: resourceRegistryScanningConfigurationPut,
+		Delete: resourceRegistryScanningConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_filter": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecr.ScanningRepositoryFilterType_Values(), false),
+									},
+								},
+							},
+						},
+						"scan_frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ecr.ScanFrequency_Values(), false),
+						},
+					},
+				},
+			},
+			"scan_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ecr.ScanType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRegistryScanningConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	input := &ecr.PutRegistryScanningConfigurationInput{
+		Rules:    expandRegistryScanningRules(d.Get("rule").(*schema.Set).List()),
+		ScanType: aws.String(d.Get("scan_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting ECR Registry Scanning Configuration: %s", input)
+	_, err := conn.PutRegistryScanningConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ECR Registry Scanning Configuration: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceRegistryScanningConfigurationRead(d, meta)
+}
+
+func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("registry_id", output.RegistryId)
+
+	if v := output.ScanningConfiguration; v != nil {
+		if err := d.Set("rule", flattenRegistryScanningRules(v.Rules)); err != nil {
+			return fmt.Errorf("error setting rule: %w", err)
+		}
+		d.Set("scan_type", v.ScanType)
+	} else {
+		d.Set("rule", nil)
+		d.Set("scan_type", nil)
+	}
+
+	return nil
+}
+
+func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	// Reset the registry to basic scanning with no rules.
+	log.Printf("[DEBUG] Deleting ECR Registry Scanning Configuration: %s", d.Id())
+	_, err := conn.PutRegistryScanningConfiguration(&ecr.PutRegistryScanningConfigurationInput{
+		Rules:    []*ecr.RegistryScanningRule{},
+		ScanType: aws.String(ecr.ScanTypeBasic),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRegistryScanningRules(tfList []interface{}) []*ecr.RegistryScanningRule {
+	apiObjects := []*ecr.RegistryScanningRule{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecr.RegistryScanningRule{}
+
+		if v, ok := tfMap["repository_filter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.RepositoryFilters = expandScanningRepositoryFilters(v.List())
+		}
+
+		if v, ok := tfMap["scan_frequency"].(string); ok && v != "" {
+			apiObject.ScanFrequency = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandScanningRepositoryFilters(tfList []interface{}) []*ecr.ScanningRepositoryFilter {
+	var apiObjects []*ecr.ScanningRepositoryFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecr.ScanningRepositoryFilter{}
+
+		if v, ok := tfMap["filter"].(string); ok && v != "" {
+			apiObject.Filter = aws.String(v)
+		}
+
+		if v, ok := tfMap["filter_type"].(string); ok && v != "" {
+			apiObject.FilterType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRegistryScanningRules(apiObjects []*ecr.RegistryScanningRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"repository_filter": flattenScanningRepositoryFilters(apiObject.RepositoryFilters),
+			"scan_frequency":    aws.StringValue(apiObject.ScanFrequency),
+		})
+	}
+
+	return tfList
+}
+
+func flattenScanningRepositoryFilters(apiObjects []*ecr.ScanningRepositoryFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"filter":      aws.StringValue(apiObject.Filter),
+			"filter_type": aws.StringValue(apiObject.FilterType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,209 @@
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccECRRegistryScanningConfiguration_serial(t *testing.T) {
+	testFuncs := map[string]func(t *testing.T){
+		"basic":  testAccRegistryScanningConfiguration_basic,
+		"update": testAccRegistryScanningConfiguration_update,
+	}
+
+	for name, testFunc := range testFuncs {
+		testFunc := testFunc
+
+		t.Run(name, func(t *testing.T) {
+			testFunc(t)
+		})
+	}
+}
+
+func testAccRegistryScanningConfiguration_basic(t *testing.T) {
+	var v ecr.GetRegistryScanningConfigurationOutput
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningConfigurationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryScanningConfigurationExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "BASIC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRegistryScanningConfiguration_update(t *testing.T) {
+	var v ecr.GetRegistryScanningConfigurationOutput
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningConfigurationOneRuleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryScanningConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency":      "SCAN_ON_PUSH",
+						"repository_filter.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.repository_filter.*", map[string]string{
+						"filter":      "example",
+						"filter_type": "WILDCARD",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "BASIC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryScanningConfigurationTwoRulesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryScanningConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency":      "CONTINUOUS_SCAN",
+						"repository_filter.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency":      "SCAN_ON_PUSH",
+						"repository_filter.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegistryScanningConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_registry_scanning_configuration" {
+			continue
+		}
+
+		output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if v := output.ScanningConfiguration; v != nil && (len(v.Rules) != 0 || v.ScanType == nil || *v.ScanType != ecr.ScanTypeBasic) {
+			return fmt.Errorf("ECR Registry Scanning Configuration %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRegistryScanningConfigurationExists(n string, v *ecr.GetRegistryScanningConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Registry Scanning Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+		output, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRegistryScanningConfigurationConfig() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+}
+`
+}
+
+func testAccRegistryScanningConfigurationOneRuleConfig() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
+
+func testAccRegistryScanningConfigurationTwoRulesConfig() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "ENHANCED"
+
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+
+    repository_filter {
+      filter      = "test"
+      filter_type = "WILDCARD"
+    }
+  }
+
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+
+    repository_filter {
+      filter      = "foo"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,115 @@
+package ecs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Value used to reset an account setting default on deletion.
+	accountSettingDefaultValueDisabled = "disabled"
+)
+
+func ResourceAccountSettingDefault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountSettingDefaultPut,
+		Read:   resourceAccountSettingDefaultRead,
+		Update: resourceAccountSettingDefaultPut,
+		Delete: resourceAccountSettingDefaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ecs.SettingName_Values(), false),
+			},
+			"principal_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAccountSettingDefaultPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	name := d.Get("name").(string)
+	input := &ecs.PutAccountSettingDefaultInput{
+		Name:  aws.String(name),
+		Value: aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting ECS Account Setting Default: %s", input)
+	_, err := conn.PutAccountSettingDefault(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ECS Account Setting Default (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAccountSettingDefaultRead(d, meta)
+}
+
+func resourceAccountSettingDefaultRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	setting, err := FindEffectiveAccountSettingByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Account Setting Default (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECS Account Setting Default (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", setting.Name)
+	d.Set("principal_arn", setting.PrincipalArn)
+	d.Set("value", setting.Value)
+
+	return nil
+}
+
+func resourceAccountSettingDefaultDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	// Account setting defaults cannot be deleted, only reset.
+	input := &ecs.PutAccountSettingDefaultInput{
+		Name:  aws.String(d.Id()),
+		Value: aws.String(accountSettingDefaultValueDisabled),
+	}
+
+	log.Printf("[DEBUG] Resetting ECS Account Setting Default: %s", input)
+	_, err := conn.PutAccountSettingDefault(input)
+
+	if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "You can no longer disable") {
+		log.Printf("[WARN] Unable to reset ECS Account Setting Default (%s): %s", d.Id(), err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting ECS Account Setting Default (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+func TestAccECSAccountSettingDefault_serial(t *testing.T) {
+	testFuncs := map[string]func(t *testing.T){
+		"containerInsights": testAccAccountSettingDefault_containerInsights,
+		"awsvpcTrunking":    testAccAccountSettingDefault_awsvpcTrunking,
+	}
+
+	for name, testFunc := range testFuncs {
+		testFunc := testFunc
+
+		t.Run(name, func(t *testing.T) {
+			testFunc(t)
+		})
+	}
+}
+
+func testAccAccountSettingDefault_containerInsights(t *testing.T) {
+	resourceName := "aws_ecs_account_setting_default.test"
+	settingName := ecs.SettingNameContainerInsights
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountSettingDefaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingDefaultConfig(settingName, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingDefaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", settingName),
+					resource.TestCheckResourceAttr(resourceName, "value", "enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingDefaultConfig(settingName, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingDefaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", settingName),
+					resource.TestCheckResourceAttr(resourceName, "value", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettingDefault_awsvpcTrunking(t *testing.T) {
+	resourceName := "aws_ecs_account_setting_default.test"
+	settingName := ecs.SettingNameAwsvpcTrunking
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountSettingDefaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingDefaultConfig(settingName, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingDefaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", settingName),
+					resource.TestCheckResourceAttr(resourceName, "value", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingDefaultDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_account_setting_default" {
+			continue
+		}
+
+		setting, err := tfecs.FindEffectiveAccountSettingByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if v := *setting.Value; v != "disabled" {
+			return fmt.Errorf("ECS Account Setting Default (%s) still has value %q", rs.Primary.ID, v)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAccountSettingDefaultExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECS Account Setting Default ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+		_, err := tfecs.FindEffectiveAccountSettingByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAccountSettingDefaultConfig(name, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_account_setting_default" "test" {
+  name  = %[1]q
+  value = %[2]q
+}
+`, name, value)
+}
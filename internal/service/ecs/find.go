@@ -65,3 +65,69 @@ func FindClusterByARN(conn *ecs.ECS, arn string) (*ecs.DescribeClustersOutput, e
 
 	return output, nil
 }
+
+// FindClusterByNameOrARN returns the active cluster corresponding to the specified name or ARN.
+// Returns NotFoundError if no active cluster is found.
+func FindClusterByNameOrARN(conn *ecs.ECS, nameOrARN string) (*ecs.Cluster, error) {
+	output, err := FindClusterByARN(conn, nameOrARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message: "Empty result",
+		}
+	}
+
+	cluster := output.Clusters[0]
+
+	if status := aws.StringValue(cluster.Status); status == "INACTIVE" {
+		return nil, &resource.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return cluster, nil
+}
+
+// FindEffectiveAccountSettingByName returns the effective account setting corresponding to the specified name.
+// Returns NotFoundError if no account setting is found.
+func FindEffectiveAccountSettingByName(conn *ecs.ECS, name string) (*ecs.Setting, error) {
+	input := &ecs.ListAccountSettingsInput{
+		EffectiveSettings: aws.Bool(true),
+		Name:              aws.String(name),
+	}
+
+	var setting *ecs.Setting
+
+	err := conn.ListAccountSettingsPages(input, func(page *ecs.ListAccountSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Settings {
+			if v != nil && aws.StringValue(v.Name) == name {
+				setting = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if setting == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return setting, nil
+}
@@ -168,7 +168,7 @@ func ResourceTargetGroup() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentTypeStringBoolean,
-				ValidateFunc:     verify.ValidTypeStringNullableBoolean,
+				ValidateFunc:     nullable.ValidateTypeStringNullableBoolStrict,
 			},
 			"protocol": {
 				Type:         schema.TypeString,
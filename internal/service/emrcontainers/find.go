@@ -0,0 +1,41 @@
+package emrcontainers
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindVirtualClusterByID(conn *emrcontainers.EMRContainers, id string) (*emrcontainers.VirtualCluster, error) {
+	input := &emrcontainers.DescribeVirtualClusterInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeVirtualCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VirtualCluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.VirtualCluster.State); state == emrcontainers.VirtualClusterStateTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.VirtualCluster, nil
+}
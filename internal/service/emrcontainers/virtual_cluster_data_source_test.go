@@ -0,0 +1,48 @@
+package emrcontainers_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEMRContainersVirtualClusterDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_emrcontainers_virtual_cluster.test"
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(emrcontainers.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "container_provider.#", dataSourceResourceName, "container_provider.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "container_provider.0.id", dataSourceResourceName, "container_provider.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "container_provider.0.info.0.eks_info.0.namespace", dataSourceResourceName, "container_provider.0.info.0.eks_info.0.namespace"),
+					resource.TestCheckResourceAttrPair(resourceName, "container_provider.0.type", dataSourceResourceName, "container_provider.0.type"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceResourceName, "virtual_cluster_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceResourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "state", "RUNNING"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceResourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVirtualClusterDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVirtualClusterConfig(rName), `
+data "aws_emrcontainers_virtual_cluster" "test" {
+  virtual_cluster_id = aws_emrcontainers_virtual_cluster.test.id
+}
+`)
+}
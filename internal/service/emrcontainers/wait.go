@@ -0,0 +1,26 @@
+package emrcontainers
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitVirtualClusterDeleted(conn *emrcontainers.EMRContainers, id string, timeout time.Duration) (*emrcontainers.VirtualCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.VirtualClusterStateTerminating},
+		Target:  []string{},
+		Refresh: statusVirtualCluster(conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*emrcontainers.VirtualCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,56 @@
+package emrserverless
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	applicationCreatedTimeout = 75 * time.Minute
+	applicationDeletedTimeout = 75 * time.Minute
+)
+
+func waitApplicationCreated(conn *emrserverless.EMRServerless, id string) (*emrserverless.Application, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrserverless.ApplicationStateCreating},
+		Target:  []string{emrserverless.ApplicationStateCreated},
+		Refresh: statusApplication(conn, id),
+		Timeout: applicationCreatedTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*emrserverless.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationTerminated(conn *emrserverless.EMRServerless, id string) (*emrserverless.Application, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: emrserverless.ApplicationState_Values(),
+		Target:  []string{},
+		Refresh: statusApplication(conn, id),
+		Timeout: applicationDeletedTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*emrserverless.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,37 @@
+package fis
+
+const (
+	actionTargetKeyCluster       = "Cluster"
+	actionTargetKeyClusters      = "Clusters"
+	actionTargetKeyDBInstances   = "DBInstances"
+	actionTargetKeyInstances     = "Instances"
+	actionTargetKeyNodegroups    = "Nodegroups"
+	actionTargetKeyRoles         = "Roles"
+	actionTargetKeySpotInstances = "SpotInstances"
+	actionTargetKeySubnets       = "Subnets"
+)
+
+func actionTargetKey_Values() []string {
+	return []string{
+		actionTargetKeyCluster,
+		actionTargetKeyClusters,
+		actionTargetKeyDBInstances,
+		actionTargetKeyInstances,
+		actionTargetKeyNodegroups,
+		actionTargetKeyRoles,
+		actionTargetKeySpotInstances,
+		actionTargetKeySubnets,
+	}
+}
+
+const (
+	stopConditionSourceCloudWatchAlarm = "aws:cloudwatch:alarm"
+	stopConditionSourceNone            = "none"
+)
+
+func stopConditionSource_Values() []string {
+	return []string{
+		stopConditionSourceCloudWatchAlarm,
+		stopConditionSourceNone,
+	}
+}
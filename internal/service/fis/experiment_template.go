@@ -0,0 +1,840 @@
+package fis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExperimentTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExperimentTemplateCreate,
+		Read:   resourceExperimentTemplateRead,
+		Update: resourceExperimentTemplateUpdate,
+		Delete: resourceExperimentTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`^aws:[a-z0-9-]+:[a-zA-Z0-9/-]+$`), "must be of the form aws:<service>:<action>"),
+							),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 512),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 64),
+											validation.StringMatch(regexp.MustCompile(`^[\S]+$`), "must not contain whitespace"),
+										),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+								},
+							},
+						},
+						"start_after": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(actionTargetKey_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stop_condition": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stopConditionSource_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"resource_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"resource_tag": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+								},
+							},
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"selection_mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 64),
+								validation.StringMatch(regexp.MustCompile(`^(ALL|COUNT\(\d+\)|PERCENT\(\d+\))$`), "must be one of ALL, COUNT(n) or PERCENT(n)"),
+							),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceExperimentTemplateCustomizeDiff,
+		),
+	}
+}
+
+func resourceExperimentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &fis.CreateExperimentTemplateInput{
+		Actions:        expandExperimentTemplateActionsForCreate(d.Get("action").(*schema.Set)),
+		Description:    aws.String(d.Get("description").(string)),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+		StopConditions: expandExperimentTemplateStopConditionsForCreate(d.Get("stop_condition").(*schema.Set)),
+		Targets:        expandExperimentTemplateTargetsForCreate(d.Get("target").(*schema.Set)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating FIS Experiment Template: %s", input)
+	output, err := conn.CreateExperimentTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FIS Experiment Template: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ExperimentTemplate.Id))
+
+	return resourceExperimentTemplateRead(d, meta)
+}
+
+func resourceExperimentTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	experimentTemplate, err := FindExperimentTemplateByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FIS Experiment Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FIS Experiment Template (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenExperimentTemplateActions(experimentTemplate.Actions)); err != nil {
+		return fmt.Errorf("error setting action: %w", err)
+	}
+
+	d.Set("arn", experimentTemplateARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("description", experimentTemplate.Description)
+	d.Set("role_arn", experimentTemplate.RoleArn)
+
+	if err := d.Set("stop_condition", flattenExperimentTemplateStopConditions(experimentTemplate.StopConditions)); err != nil {
+		return fmt.Errorf("error setting stop_condition: %w", err)
+	}
+
+	if err := d.Set("target", flattenExperimentTemplateTargets(experimentTemplate.Targets)); err != nil {
+		return fmt.Errorf("error setting target: %w", err)
+	}
+
+	tags := KeyValueTags(experimentTemplate.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceExperimentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &fis.UpdateExperimentTemplateInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Actions = expandExperimentTemplateActionsForUpdate(d.Get("action").(*schema.Set))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("stop_condition") {
+			input.StopConditions = expandExperimentTemplateStopConditionsForUpdate(d.Get("stop_condition").(*schema.Set))
+		}
+
+		if d.HasChange("target") {
+			input.Targets = expandExperimentTemplateTargetsForUpdate(d.Get("target").(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating FIS Experiment Template: %s", input)
+		_, err := conn.UpdateExperimentTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating FIS Experiment Template (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FIS Experiment Template (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceExperimentTemplateRead(d, meta)
+}
+
+func resourceExperimentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	log.Printf("[DEBUG] Deleting FIS Experiment Template: %s", d.Id())
+	_, err := conn.DeleteExperimentTemplate(&fis.DeleteExperimentTemplateInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fis.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FIS Experiment Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceExperimentTemplateCustomizeDiff validates action parameters against
+// those supported by each action so that invalid templates fail at plan time.
+func resourceExperimentTemplateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Action IDs and parameters may come from other resources and not be known until apply.
+	if !diff.NewValueKnown("action") {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, tfMapRaw := range diff.Get("action").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var parameters []interface{}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok {
+			parameters = v.List()
+		}
+
+		for _, err := range validateExperimentTemplateAction(tfMap["action_id"].(string), parameters) {
+			errs = multierror.Append(errs, fmt.Errorf("action (%s): %w", tfMap["name"].(string), err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func experimentTemplateARN(client *conns.AWSClient, id string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "fis",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("experiment-template/%s", id),
+	}.String()
+}
+
+func expandExperimentTemplateActionsForCreate(tfSet *schema.Set) map[string]*fis.CreateExperimentTemplateActionInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.CreateExperimentTemplateActionInput)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.CreateExperimentTemplateActionInput{}
+
+		if v, ok := tfMap["action_id"].(string); ok && v != "" {
+			apiObject.ActionId = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandKeyValuePairs(v)
+		}
+
+		if v, ok := tfMap["start_after"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.StartAfter = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Targets = expandExperimentTemplateActionTarget(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObjects[v] = apiObject
+		}
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateActionsForUpdate(tfSet *schema.Set) map[string]*fis.UpdateExperimentTemplateActionInputItem {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.UpdateExperimentTemplateActionInputItem)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.UpdateExperimentTemplateActionInputItem{}
+
+		if v, ok := tfMap["action_id"].(string); ok && v != "" {
+			apiObject.ActionId = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandKeyValuePairs(v)
+		}
+
+		if v, ok := tfMap["start_after"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.StartAfter = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Targets = expandExperimentTemplateActionTarget(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObjects[v] = apiObject
+		}
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateActionTarget(tfMap map[string]interface{}) map[string]*string {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := make(map[string]*string)
+
+	if k, ok := tfMap["key"].(string); ok && k != "" {
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject[k] = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandExperimentTemplateStopConditionsForCreate(tfSet *schema.Set) []*fis.CreateExperimentTemplateStopConditionInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	var apiObjects []*fis.CreateExperimentTemplateStopConditionInput
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.CreateExperimentTemplateStopConditionInput{}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.Source = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateStopConditionsForUpdate(tfSet *schema.Set) []*fis.UpdateExperimentTemplateStopConditionInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	var apiObjects []*fis.UpdateExperimentTemplateStopConditionInput
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.UpdateExperimentTemplateStopConditionInput{}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.Source = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateTargetsForCreate(tfSet *schema.Set) map[string]*fis.CreateExperimentTemplateTargetInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.CreateExperimentTemplateTargetInput)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.CreateExperimentTemplateTargetInput{}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Filters = expandExperimentTemplateTargetFilters(v)
+		}
+
+		if v, ok := tfMap["resource_arns"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceArns = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["resource_tag"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTags = expandKeyValuePairs(v)
+		}
+
+		if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+			apiObject.ResourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["selection_mode"].(string); ok && v != "" {
+			apiObject.SelectionMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObjects[v] = apiObject
+		}
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateTargetsForUpdate(tfSet *schema.Set) map[string]*fis.UpdateExperimentTemplateTargetInput {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*fis.UpdateExperimentTemplateTargetInput)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.UpdateExperimentTemplateTargetInput{}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Filters = expandExperimentTemplateTargetFilters(v)
+		}
+
+		if v, ok := tfMap["resource_arns"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceArns = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["resource_tag"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTags = expandKeyValuePairs(v)
+		}
+
+		if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+			apiObject.ResourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["selection_mode"].(string); ok && v != "" {
+			apiObject.SelectionMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObjects[v] = apiObject
+		}
+	}
+
+	return apiObjects
+}
+
+func expandExperimentTemplateTargetFilters(tfList []interface{}) []*fis.ExperimentTemplateTargetInputFilter {
+	var apiObjects []*fis.ExperimentTemplateTargetInputFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &fis.ExperimentTemplateTargetInputFilter{}
+
+		if v, ok := tfMap["path"].(string); ok && v != "" {
+			apiObject.Path = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Values = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandKeyValuePairs(tfSet *schema.Set) map[string]*string {
+	apiObject := make(map[string]*string)
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if k, ok := tfMap["key"].(string); ok && k != "" {
+			apiObject[k] = aws.String(tfMap["value"].(string))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenExperimentTemplateActions(apiObjects map[string]*fis.ExperimentTemplateAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_id":   aws.StringValue(apiObject.ActionId),
+			"description": aws.StringValue(apiObject.Description),
+			"name":        name,
+			"parameter":   flattenKeyValuePairs(apiObject.Parameters),
+			"start_after": aws.StringValueSlice(apiObject.StartAfter),
+			"target":      flattenExperimentTemplateActionTarget(apiObject.Targets),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateActionTarget(apiObject map[string]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for k, v := range apiObject {
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"value": aws.StringValue(v),
+		})
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateStopConditions(apiObjects []*fis.ExperimentTemplateStopCondition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"source": aws.StringValue(apiObject.Source),
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateTargets(apiObjects map[string]*fis.ExperimentTemplateTarget) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"filter":         flattenExperimentTemplateTargetFilters(apiObject.Filters),
+			"name":           name,
+			"resource_arns":  aws.StringValueSlice(apiObject.ResourceArns),
+			"resource_tag":   flattenKeyValuePairs(apiObject.ResourceTags),
+			"resource_type":  aws.StringValue(apiObject.ResourceType),
+			"selection_mode": aws.StringValue(apiObject.SelectionMode),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenExperimentTemplateTargetFilters(apiObjects []*fis.ExperimentTemplateTargetFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"path":   aws.StringValue(apiObject.Path),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
+
+func flattenKeyValuePairs(apiObject map[string]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for k, v := range apiObject {
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"value": aws.StringValue(v),
+		})
+	}
+
+	return tfList
+}
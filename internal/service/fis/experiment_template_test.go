@@ -0,0 +1,480 @@
+package fis_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffis "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFISExperimentTemplate_basic(t *testing.T) {
+	var v fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fis.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fis.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "fis", regexp.MustCompile(`experiment-template/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"action_id":      "aws:ec2:terminate-instances",
+						"description":    "terminate instances",
+						"name":           "terminate",
+						"target.#":       "1",
+						"target.0.key":   "Instances",
+						"target.0.value": "instances",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "stop_condition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "stop_condition.*", map[string]string{
+						"source": "none",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "target.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target.*", map[string]string{
+						"name":           "instances",
+						"resource_tag.#": "1",
+						"resource_type":  "aws:ec2:instance",
+						"selection_mode": "COUNT(1)",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateConfig(rName, "test updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_disappears(t *testing.T) {
+	var v fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fis.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fis.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tffis.ResourceExperimentTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_parametersAndStopCondition(t *testing.T) {
+	var v fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fis.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fis.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateParametersConfig(rName, "PT1M"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"action_id":   "aws:ec2:stop-instances",
+						"name":        "stop",
+						"parameter.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*.parameter.*", map[string]string{
+						"key":   "startInstancesAfterDuration",
+						"value": "PT1M",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "stop_condition.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "stop_condition.*.value", "aws_cloudwatch_metric_alarm.test", "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target.*", map[string]string{
+						"name":            "instances",
+						"resource_arns.#": "1",
+						"selection_mode":  "ALL",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateParametersConfig(rName, "PT2M"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*.parameter.*", map[string]string{
+						"key":   "startInstancesAfterDuration",
+						"value": "PT2M",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_invalidParameter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fis.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fis.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExperimentTemplateInvalidParameterConfig(rName),
+				ExpectError: regexp.MustCompile(`must not contain whitespace`),
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_tags(t *testing.T) {
+	var v fis.ExperimentTemplate
+	resourceName := "aws_fis_experiment_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fis.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fis.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccExperimentTemplateTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExperimentTemplateExists(n string, v *fis.ExperimentTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FIS Experiment Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+		output, err := tffis.FindExperimentTemplateByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckExperimentTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fis_experiment_template" {
+			continue
+		}
+
+		_, err := tffis.FindExperimentTemplateByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("FIS Experiment Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccExperimentTemplateBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "fis.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccExperimentTemplateConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name        = "terminate"
+    description = "terminate instances"
+    action_id   = "aws:ec2:terminate-instances"
+
+    target {
+      key   = "Instances"
+      value = "instances"
+    }
+  }
+
+  target {
+    name           = "instances"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "COUNT(1)"
+
+    resource_tag {
+      key   = "Name"
+      value = %[1]q
+    }
+  }
+}
+`, rName, description))
+}
+
+func testAccExperimentTemplateParametersConfig(rName, duration string) string {
+	return acctest.ConfigCompose(
+		testAccExperimentTemplateBaseConfig(rName),
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+
+  dimensions = {
+    InstanceId = aws_instance.test.id
+  }
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = "test"
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "aws:cloudwatch:alarm"
+    value  = aws_cloudwatch_metric_alarm.test.arn
+  }
+
+  action {
+    name      = "stop"
+    action_id = "aws:ec2:stop-instances"
+
+    parameter {
+      key   = "startInstancesAfterDuration"
+      value = %[2]q
+    }
+
+    target {
+      key   = "Instances"
+      value = "instances"
+    }
+  }
+
+  target {
+    name           = "instances"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "ALL"
+    resource_arns  = [aws_instance.test.arn]
+  }
+}
+`, rName, duration))
+}
+
+func testAccExperimentTemplateInvalidParameterConfig(rName string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), `
+resource "aws_fis_experiment_template" "test" {
+  description = "test"
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "stop"
+    action_id = "aws:ec2:stop-instances"
+
+    parameter {
+      key   = "start instances after duration"
+      value = "PT1M"
+    }
+  }
+}
+`)
+}
+
+func testAccExperimentTemplateTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = "test"
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "terminate"
+    action_id = "aws:ec2:terminate-instances"
+
+    target {
+      key   = "Instances"
+      value = "instances"
+    }
+  }
+
+  target {
+    name           = "instances"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "COUNT(1)"
+
+    resource_tag {
+      key   = "Name"
+      value = %[1]q
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccExperimentTemplateTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = "test"
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "terminate"
+    action_id = "aws:ec2:terminate-instances"
+
+    target {
+      key   = "Instances"
+      value = "instances"
+    }
+  }
+
+  target {
+    name           = "instances"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "COUNT(1)"
+
+    resource_tag {
+      key   = "Name"
+      value = %[1]q
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
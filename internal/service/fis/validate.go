@@ -0,0 +1,221 @@
+package fis
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// actionParameter describes a parameter of a known FIS action.
+type actionParameter struct {
+	required bool
+	validate func(string) error
+}
+
+// actionParameters is the set of parameters supported by each known FIS action.
+// See https://docs.aws.amazon.com/fis/latest/userguide/fis-actions-reference.html.
+var actionParameters = map[string]map[string]actionParameter{
+	"aws:cloudwatch:assert-alarm-state": {
+		"alarmArns":   {required: true, validate: validateActionParameterARNList},
+		"alarmStates": {required: true, validate: validateActionParameterAlarmStates},
+	},
+	"aws:ebs:pause-volume-io": {
+		"duration": {required: true, validate: validateActionParameterDuration},
+	},
+	"aws:ec2:reboot-instances": {},
+	"aws:ec2:send-spot-instance-interruptions": {
+		"durationBeforeInterruption": {required: true, validate: validateActionParameterDuration},
+	},
+	"aws:ec2:stop-instances": {
+		"startInstancesAfterDuration": {validate: validateActionParameterDuration},
+	},
+	"aws:ec2:terminate-instances": {},
+	"aws:ecs:drain-container-instances": {
+		"drainagePercentage": {required: true, validate: validateActionParameterPercentage},
+		"duration":           {required: true, validate: validateActionParameterDuration},
+	},
+	"aws:ecs:stop-task": {},
+	"aws:eks:terminate-nodegroup-instances": {
+		"instanceTerminationPercentage": {required: true, validate: validateActionParameterPercentage},
+	},
+	"aws:fis:inject-api-internal-error":    apiErrorActionParameters,
+	"aws:fis:inject-api-throttle-error":    apiErrorActionParameters,
+	"aws:fis:inject-api-unavailable-error": apiErrorActionParameters,
+	"aws:fis:wait": {
+		"duration": {required: true, validate: validateActionParameterDuration},
+	},
+	"aws:network:disrupt-connectivity": {
+		"duration": {required: true, validate: validateActionParameterDuration},
+		"scope":    {validate: validateActionParameterInSlice("all", "availability-zone", "dynamodb", "prefix-list", "s3")},
+	},
+	"aws:rds:failover-db-cluster": {},
+	"aws:rds:reboot-db-instances": {
+		"forceFailover": {validate: validateActionParameterInSlice("true", "false")},
+	},
+	"aws:ssm:send-command": {
+		"documentArn":        {required: true, validate: validateActionParameterARN},
+		"documentParameters": {},
+		"documentVersion":    {},
+		"duration":           {required: true, validate: validateActionParameterDuration},
+	},
+	"aws:ssm:start-automation-execution": {
+		"documentArn":        {required: true, validate: validateActionParameterARN},
+		"documentParameters": {},
+		"documentVersion":    {},
+		"maxDuration":        {required: true, validate: validateActionParameterDuration},
+	},
+}
+
+var apiErrorActionParameters = map[string]actionParameter{
+	"duration":   {required: true, validate: validateActionParameterDuration},
+	"operations": {},
+	"percentage": {required: true, validate: validateActionParameterPercentage},
+	"service":    {required: true},
+}
+
+// validateExperimentTemplateAction validates the parameters of an experiment template action.
+// Unknown values are read as empty strings during plan, so actions whose ID or
+// parameter keys are empty are not validated.
+func validateExperimentTemplateAction(actionID string, tfList []interface{}) []error {
+	if actionID == "" {
+		return nil
+	}
+
+	parameters := map[string]string{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+
+		if key == "" {
+			return nil
+		}
+
+		parameters[key] = tfMap["value"].(string)
+	}
+
+	return validateActionParameters(actionID, parameters)
+}
+
+// validateActionParameters validates the specified parameters against those supported by the FIS action.
+// Actions not known to the provider are not validated, as FIS adds actions over time.
+// Empty values, which include values not known until apply, are not validated.
+func validateActionParameters(actionID string, parameters map[string]string) []error {
+	supported, ok := actionParameters[actionID]
+
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+
+	keys := make([]string, 0, len(parameters))
+	for k := range parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		parameter, ok := supported[k]
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("parameter %q is not supported by action %s, expected one of [%s]", k, actionID, strings.Join(actionParameterKeys(supported), ", ")))
+			continue
+		}
+
+		if v := parameters[k]; v != "" && parameter.validate != nil {
+			if err := parameter.validate(v); err != nil {
+				errs = append(errs, fmt.Errorf("parameter %q: %w", k, err))
+			}
+		}
+	}
+
+	for _, k := range actionParameterKeys(supported) {
+		if _, ok := parameters[k]; !ok && supported[k].required {
+			errs = append(errs, fmt.Errorf("parameter %q is required by action %s", k, actionID))
+		}
+	}
+
+	return errs
+}
+
+func actionParameterKeys(parameters map[string]actionParameter) []string {
+	keys := make([]string, 0, len(parameters))
+	for k := range parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+var actionParameterDurationRegexp = regexp.MustCompile(`^P(?:\d+D)?(?:T(?:\d+H)?(?:\d+M)?(?:\d+S)?)?$`)
+
+func validateActionParameterDuration(v string) error {
+	if !actionParameterDurationRegexp.MatchString(v) || v == "P" || strings.HasSuffix(v, "T") {
+		return fmt.Errorf("expected an ISO 8601 duration such as PT5M, got %q", v)
+	}
+
+	return nil
+}
+
+func validateActionParameterPercentage(v string) error {
+	n, err := strconv.Atoi(v)
+
+	if err != nil || n < 1 || n > 100 {
+		return fmt.Errorf("expected a whole number percentage between 1 and 100, got %q", v)
+	}
+
+	return nil
+}
+
+func validateActionParameterARN(v string) error {
+	if !arn.IsARN(v) {
+		return fmt.Errorf("expected an ARN, got %q", v)
+	}
+
+	return nil
+}
+
+func validateActionParameterARNList(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		if err := validateActionParameterARN(strings.TrimSpace(s)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateActionParameterAlarmStates(v string) error {
+	validate := validateActionParameterInSlice("ALARM", "INSUFFICIENT_DATA", "OK")
+
+	for _, s := range strings.Split(v, ",") {
+		if err := validate(strings.TrimSpace(s)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateActionParameterInSlice(valid ...string) func(string) error {
+	return func(v string) error {
+		for _, s := range valid {
+			if v == s {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected one of [%s], got %q", strings.Join(valid, ", "), v)
+	}
+}
@@ -0,0 +1,212 @@
+package fis
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidateActionParameters(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ActionID       string
+		Parameters     map[string]string
+		ExpectedErrors []*regexp.Regexp
+	}{
+		{
+			Name:     "unknown action",
+			ActionID: "aws:example:unknown-action",
+			Parameters: map[string]string{
+				"anything": "value",
+			},
+		},
+		{
+			Name:       "no parameters",
+			ActionID:   "aws:ec2:terminate-instances",
+			Parameters: map[string]string{},
+		},
+		{
+			Name:     "unsupported parameter",
+			ActionID: "aws:ec2:terminate-instances",
+			Parameters: map[string]string{
+				"duration": "PT1M",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "duration" is not supported by action aws:ec2:terminate-instances, expected one of \[\]`),
+			},
+		},
+		{
+			Name:     "optional duration",
+			ActionID: "aws:ec2:stop-instances",
+			Parameters: map[string]string{
+				"startInstancesAfterDuration": "PT10M",
+			},
+		},
+		{
+			Name:     "misspelled parameter",
+			ActionID: "aws:ec2:stop-instances",
+			Parameters: map[string]string{
+				"startInstanceAfterDuration": "PT10M",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "startInstanceAfterDuration" is not supported by action aws:ec2:stop-instances, expected one of \[startInstancesAfterDuration\]`),
+			},
+		},
+		{
+			Name:     "invalid duration",
+			ActionID: "aws:ec2:stop-instances",
+			Parameters: map[string]string{
+				"startInstancesAfterDuration": "10m",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "startInstancesAfterDuration": expected an ISO 8601 duration such as PT5M, got "10m"`),
+			},
+		},
+		{
+			Name:     "empty duration time designator",
+			ActionID: "aws:fis:wait",
+			Parameters: map[string]string{
+				"duration": "PT",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "duration": expected an ISO 8601 duration`),
+			},
+		},
+		{
+			Name:     "unknown value",
+			ActionID: "aws:fis:wait",
+			Parameters: map[string]string{
+				"duration": "",
+			},
+		},
+		{
+			Name:       "missing required parameter",
+			ActionID:   "aws:fis:wait",
+			Parameters: map[string]string{},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "duration" is required by action aws:fis:wait`),
+			},
+		},
+		{
+			Name:     "api error",
+			ActionID: "aws:fis:inject-api-throttle-error",
+			Parameters: map[string]string{
+				"duration":   "PT1H",
+				"operations": "DescribeInstances,DescribeVolumes",
+				"percentage": "50",
+				"service":    "ec2",
+			},
+		},
+		{
+			Name:     "invalid percentages",
+			ActionID: "aws:fis:inject-api-internal-error",
+			Parameters: map[string]string{
+				"duration":   "P1DT2H",
+				"percentage": "101",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "percentage": expected a whole number percentage between 1 and 100, got "101"`),
+				regexp.MustCompile(`parameter "service" is required by action aws:fis:inject-api-internal-error`),
+			},
+		},
+		{
+			Name:     "alarm state",
+			ActionID: "aws:cloudwatch:assert-alarm-state",
+			Parameters: map[string]string{
+				"alarmArns":   "arn:aws:cloudwatch:us-west-2:123456789012:alarm:a, arn:aws:cloudwatch:us-west-2:123456789012:alarm:b", //lintignore:AWSAT003,AWSAT005
+				"alarmStates": "OK,ALARM",
+			},
+		},
+		{
+			Name:     "invalid alarm state",
+			ActionID: "aws:cloudwatch:assert-alarm-state",
+			Parameters: map[string]string{
+				"alarmArns":   "my-alarm",
+				"alarmStates": "OK,FIRING",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "alarmArns": expected an ARN, got "my-alarm"`),
+				regexp.MustCompile(`parameter "alarmStates": expected one of \[ALARM, INSUFFICIENT_DATA, OK\], got "FIRING"`),
+			},
+		},
+		{
+			Name:     "boolean",
+			ActionID: "aws:rds:reboot-db-instances",
+			Parameters: map[string]string{
+				"forceFailover": "yes",
+			},
+			ExpectedErrors: []*regexp.Regexp{
+				regexp.MustCompile(`parameter "forceFailover": expected one of \[true, false\], got "yes"`),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			errs := validateActionParameters(testCase.ActionID, testCase.Parameters)
+
+			if got, expected := len(errs), len(testCase.ExpectedErrors); got != expected {
+				t.Fatalf("expected %d errors, got %d: %v", expected, got, errs)
+			}
+
+			for i, err := range errs {
+				if !testCase.ExpectedErrors[i].MatchString(err.Error()) {
+					t.Errorf("expected error %d to match %q, got %s", i, testCase.ExpectedErrors[i], err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateExperimentTemplateAction(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ActionID       string
+		Parameters     []interface{}
+		ExpectedErrors int
+	}{
+		{
+			Name:     "unknown action_id",
+			ActionID: "",
+			Parameters: []interface{}{
+				map[string]interface{}{"key": "duration", "value": "10m"},
+			},
+		},
+		{
+			Name:     "unknown parameter key",
+			ActionID: "aws:fis:wait",
+			Parameters: []interface{}{
+				map[string]interface{}{"key": "", "value": "PT1M"},
+			},
+		},
+		{
+			Name:     "unknown parameter value",
+			ActionID: "aws:fis:wait",
+			Parameters: []interface{}{
+				map[string]interface{}{"key": "duration", "value": ""},
+			},
+		},
+		{
+			Name:     "invalid parameter value",
+			ActionID: "aws:fis:wait",
+			Parameters: []interface{}{
+				map[string]interface{}{"key": "duration", "value": "10m"},
+			},
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "missing required parameter",
+			ActionID:       "aws:fis:wait",
+			ExpectedErrors: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			errs := validateExperimentTemplateAction(testCase.ActionID, testCase.Parameters)
+
+			if got, expected := len(errs), testCase.ExpectedErrors; got != expected {
+				t.Errorf("expected %d errors, got %d: %v", expected, got, errs)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func suppressOpenIDURL(k, old, new string, d *schema.ResourceData) bool {
@@ -21,3 +22,16 @@ func suppressOpenIDURL(k, old, new string, d *schema.ResourceData) bool {
 
 	return oldUrl.String() == newUrl.String()
 }
+
+// suppressEquivalentPolicyDiffs suppresses differences between semantically
+// equivalent IAM policy documents.
+// Documents that cannot be canonicalized, e.g. those containing elements unknown
+// to the canonicalizer, are compared using verify.SuppressEquivalentPolicyDiffs.
+func suppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	equivalent, err := PoliciesAreEquivalent(old, new)
+	if err != nil {
+		return verify.SuppressEquivalentPolicyDiffs(k, old, new, d)
+	}
+
+	return equivalent
+}
@@ -0,0 +1,1238 @@
+package iam
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentPolicyDiffs(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Policy1    string
+		Policy2    string
+		Equivalent bool
+	}{
+		{
+			Name:       "Invalid policy JSON",
+			Policy1:    testPolicyEquivalence0,
+			Policy2:    testPolicyEquivalence0,
+			Equivalent: false,
+		},
+		{
+			Name:       "Identical policy text",
+			Policy1:    testPolicyEquivalence1,
+			Policy2:    testPolicyEquivalence1,
+			Equivalent: true,
+		},
+		{
+			Name:       "Action block as single item array versus string",
+			Policy1:    testPolicyEquivalence2a,
+			Policy2:    testPolicyEquivalence2b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Action block as single item array versus string, different action",
+			Policy1:    testPolicyEquivalence3a,
+			Policy2:    testPolicyEquivalence3b,
+			Equivalent: false,
+		},
+		{
+			Name:       "NotAction block and ActionBlock, mixed string versus array",
+			Policy1:    testPolicyEquivalence4a,
+			Policy2:    testPolicyEquivalence4b,
+			Equivalent: true,
+		},
+		{
+			Name:       "NotAction block on one side",
+			Policy1:    testPolicyEquivalence5a,
+			Policy2:    testPolicyEquivalence5b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Principal in single item array versus string",
+			Policy1:    testPolicyEquivalence6a,
+			Policy2:    testPolicyEquivalence6b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Different principal in single item array versus string",
+			Policy1:    testPolicyEquivalence7a,
+			Policy2:    testPolicyEquivalence7b,
+			Equivalent: false,
+		},
+		{
+			Name:       "String principal",
+			Policy1:    testPolicyEquivalence8a,
+			Policy2:    testPolicyEquivalence8b,
+			Equivalent: true,
+		},
+		{
+			Name:       "String NotPrincipal",
+			Policy1:    testPolicyEquivalence9a,
+			Policy2:    testPolicyEquivalence9b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Different NotPrincipal in single item array versus string",
+			Policy1:    testPolicyEquivalence10a,
+			Policy2:    testPolicyEquivalence10b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Different Effect",
+			Policy1:    testPolicyEquivalence11a,
+			Policy2:    testPolicyEquivalence11b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Different Version",
+			Policy1:    testPolicyEquivalence12a,
+			Policy2:    testPolicyEquivalence12b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Same Condition",
+			Policy1:    testPolicyEquivalence13a,
+			Policy2:    testPolicyEquivalence13b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Different Condition",
+			Policy1:    testPolicyEquivalence14a,
+			Policy2:    testPolicyEquivalence14b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Condition in single string instead of array",
+			Policy1:    testPolicyEquivalence15a,
+			Policy2:    testPolicyEquivalence15b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Multiple Condition Blocks in one policy",
+			Policy1:    testPolicyEquivalence16a,
+			Policy2:    testPolicyEquivalence16b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Multiple Condition Blocks, same in both policies",
+			Policy1:    testPolicyEquivalence17a,
+			Policy2:    testPolicyEquivalence17b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Multiple Statements, Equivalent",
+			Policy1:    testPolicyEquivalence18a,
+			Policy2:    testPolicyEquivalence18b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Multiple Statements, missing one from policy 2",
+			Policy1:    testPolicyEquivalence19a,
+			Policy2:    testPolicyEquivalence19b,
+			Equivalent: false,
+		},
+		{
+			Name:       "Casing of Effect",
+			Policy1:    testPolicyEquivalence20a,
+			Policy2:    testPolicyEquivalence20b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Single Statement vs []Statement",
+			Policy1:    testPolicyEquivalence21a,
+			Policy2:    testPolicyEquivalence21b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Empty Principal set",
+			Policy1:    testPolicyEquivalence22a,
+			Policy2:    testPolicyEquivalence22b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Empty Principals sets of different types have the same effect",
+			Policy1:    testPolicyEquivalence23a,
+			Policy2:    testPolicyEquivalence23b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Empty Principal and missing Principal have the same effect",
+			Policy1:    testPolicyEquivalence24a,
+			Policy2:    testPolicyEquivalence24b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Principal with empty sets and missing Principal have the same effect",
+			Policy1:    testPolicyEquivalence25a,
+			Policy2:    testPolicyEquivalence25b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Principal with string root IAM user matches account ID",
+			Policy1:    testPolicyEquivalence26a,
+			Policy2:    testPolicyEquivalence26b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Principal with map string root IAM user matches account ID",
+			Policy1:    testPolicyEquivalence27a,
+			Policy2:    testPolicyEquivalence27b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Principal with map array single root IAM user matches account ID",
+			Policy1:    testPolicyEquivalence28a,
+			Policy2:    testPolicyEquivalence28b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Principal with map array multiple root IAM user matches account ID",
+			Policy1:    testPolicyEquivalence29a,
+			Policy2:    testPolicyEquivalence29b,
+			Equivalent: true,
+		},
+		{
+			Name:       "Missing Statement",
+			Policy1:    testPolicyEquivalence30,
+			Policy2:    testPolicyEquivalence30,
+			Equivalent: false,
+		},
+		{
+			Name:       "Incorrect Statement type",
+			Policy1:    testPolicyEquivalence31,
+			Policy2:    testPolicyEquivalence31,
+			Equivalent: false,
+		},
+		{
+			Name:       "Incorrect single Resource type",
+			Policy1:    testPolicyEquivalence32,
+			Policy2:    testPolicyEquivalence32,
+			Equivalent: false,
+		},
+		{
+			Name:       "Incorrect multiple Resource type",
+			Policy1:    testPolicyEquivalence33,
+			Policy2:    testPolicyEquivalence33,
+			Equivalent: false,
+		},
+		{
+			Name: "Unsupported element",
+			Policy1: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Unsupported": true}]
+}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*","Unsupported":true}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "Unsupported element, different action",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Unsupported":true}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*","Unsupported":true}]}`,
+			Equivalent: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := suppressEquivalentPolicyDiffs("policy", testCase.Policy1, testCase.Policy2, nil); got != testCase.Equivalent {
+				t.Errorf("got %t, expected %t", got, testCase.Equivalent)
+			}
+		})
+	}
+}
+
+const testPolicyEquivalence0 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+  ]
+}`
+
+const testPolicyEquivalence1 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow", "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence2a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence2b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence3a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence3b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:GetSessionToken"],
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence4a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole",
+      "NotAction": ["sts:GetSessionToken"]
+    }
+  ]
+}`
+
+const testPolicyEquivalence4b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "NotAction": "sts:GetSessionToken",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence5a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence5b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "NotAction": "sts:GetSessionToken",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence6a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence6b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": ["spotfleet.amazonaws.com"]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence7a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence7b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": ["spotfleet.amazonaws.com"]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence8a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence8b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": "*"
+    }
+  ]
+}`
+
+const testPolicyEquivalence9a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "NotPrincipal": "*",
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence9b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "NotPrincipal": "*"
+    }
+  ]
+}`
+
+const testPolicyEquivalence10a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "NotPrincipal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence10b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "NotPrincipal": {
+        "Service": ["spotfleet.amazonaws.com"]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence11a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence11b = `{
+  "Version": "2012-06-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence12a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Deny",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence12b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence13a = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringEquals": {
+         "s3:x-amz-acl": [
+           "public-read"
+         ]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence13b = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringEquals": {
+         "s3:x-amz-acl": [
+           "public-read"
+         ]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence14a = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringNotEquals": {
+         "s3:x-amz-acl": [
+           "public-read"
+         ]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence14b = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringEquals": {
+         "s3:x-amz-acl": [
+           "public-read"
+         ]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence15a = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringEquals": {
+         "s3:x-amz-acl": "public-read"
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence15b = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringEquals": {
+         "s3:x-amz-acl": [
+           "public-read"
+         ]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence16a = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition": {
+       "StringEquals": {
+         "s3:x-amz-acl": "public-read"
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence16b = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition" :  {
+       "DateGreaterThan" : {
+         "aws:CurrentTime" : "2013-08-16T12:00:00Z"
+       },
+       "DateLessThan": {
+         "aws:CurrentTime" : "2013-08-16T15:00:00Z"
+       },
+       "IpAddress" : {
+         "aws:SourceIp" : ["192.0.2.0/24", "203.0.113.0/24"]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence17a = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition" :  {
+       "DateGreaterThan" : {
+         "aws:CurrentTime" : "2013-08-16T12:00:00Z"
+       },
+       "DateLessThan": {
+         "aws:CurrentTime" : "2013-08-16T15:00:00Z"
+       },
+       "IpAddress" : {
+         "aws:SourceIp" : ["192.0.2.0/24", "203.0.113.0/24"]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence17b = `{
+ "Version": "2012-10-17",
+ "Statement": [
+   {
+     "Sid": "statement1",
+     "Effect": "Allow",
+     "Action": [
+       "s3:PutObject"
+     ],
+     "Resource": [
+       "arn:aws:s3:::examplebucket/*"
+     ],
+     "Condition" :  {
+       "DateGreaterThan" : {
+         "aws:CurrentTime" : "2013-08-16T12:00:00Z"
+       },
+       "DateLessThan": {
+         "aws:CurrentTime" : "2013-08-16T15:00:00Z"
+       },
+       "IpAddress" : {
+         "aws:SourceIp" : ["192.0.2.0/24", "203.0.113.0/24"]
+       }
+     }
+   }
+ ]
+}`
+
+const testPolicyEquivalence18a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListAllMyBuckets",
+        "s3:GetBucketLocation"
+      ],
+      "Resource": "arn:aws:s3:::*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::BUCKET-NAME",
+      "Condition": {"StringLike": {"s3:prefix": [
+        "",
+        "home/",
+        "home/${aws:username}/"
+      ]}}
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}",
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"
+      ]
+    }
+  ]
+}`
+
+const testPolicyEquivalence18b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListAllMyBuckets",
+        "s3:GetBucketLocation"
+      ],
+      "Resource": "arn:aws:s3:::*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::BUCKET-NAME",
+      "Condition": {"StringLike": {"s3:prefix": [
+        "",
+        "home/",
+        "home/${aws:username}/"
+      ]}}
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}",
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"
+      ]
+    }
+  ]
+}`
+
+const testPolicyEquivalence19a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListAllMyBuckets",
+        "s3:GetBucketLocation"
+      ],
+      "Resource": "arn:aws:s3:::*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}",
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::BUCKET-NAME",
+      "Condition": {"StringLike": {"s3:prefix": [
+        "",
+        "home/",
+        "home/${aws:username}/"
+      ]}}
+    }
+  ]
+}`
+
+const testPolicyEquivalence19b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListAllMyBuckets",
+        "s3:GetBucketLocation"
+      ],
+      "Resource": "arn:aws:s3:::*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}",
+        "arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"
+      ]
+    }
+  ]
+}`
+
+const testPolicyEquivalence20a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const testPolicyEquivalence20b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence21a = `{
+  "Version": "2012-10-17",
+  "Statement":
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+}`
+
+const testPolicyEquivalence21b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "allow",
+      "Principal": {
+        "Service": "spotfleet.amazonaws.com"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence22a = `{
+  "Version": "2012-10-17",
+  "Statement":
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {},
+      "Action": "sts:AssumeRole"
+    }
+}`
+
+const testPolicyEquivalence22b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "allow",
+      "Principal": {
+        "Service": []
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence23a = `{
+  "Version": "2012-10-17",
+  "Statement":
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": []
+      },
+      "Action": "sts:AssumeRole"
+    }
+}`
+
+const testPolicyEquivalence23b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "allow",
+      "Principal": {
+        "AWS": []
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence24a = `{
+  "Version": "2012-10-17",
+  "Statement":
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {},
+      "Action": "sts:AssumeRole"
+    }
+}`
+
+const testPolicyEquivalence24b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "allow"
+    }
+  ]
+}`
+
+const testPolicyEquivalence25a = `{
+  "Version": "2012-10-17",
+  "Statement":
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [],
+        "AWS": []
+      },
+      "Action": "sts:AssumeRole"
+    }
+}`
+
+const testPolicyEquivalence25b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Action": ["sts:AssumeRole"],
+      "Effect": "allow"
+    }
+  ]
+}`
+
+const testPolicyEquivalence26a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": "123456789012"
+    }
+  ]
+}`
+
+const testPolicyEquivalence26b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": "arn:PARTITION:iam::123456789012:root"
+    }
+  ]
+}`
+
+const testPolicyEquivalence27a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": {
+        "AWS": "123456789012"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence27b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": {
+        "AWS": "arn:PARTITION:iam::123456789012:root"
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence28a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": {
+        "AWS": [
+          "123456789012"
+        ]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence28b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": {
+        "AWS": [
+          "arn:PARTITION:iam::123456789012:root"
+        ]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence29a = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": {
+        "AWS": [
+          "123456789012",
+          "arn:PARTITION:iam::999999999999:root"
+        ]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence29b = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*",
+      "Principal": {
+        "AWS": [
+          "arn:PARTITION:iam::123456789012:root",
+          "arn:PARTITION:iam::999999999999:root"
+        ]
+      }
+    }
+  ]
+}`
+
+const testPolicyEquivalence30 = `{
+  "Version": "2012-10-17"
+}`
+
+const testPolicyEquivalence31 = `{
+  "Version": "2012-10-17",
+  "Statement": 42
+}`
+
+const testPolicyEquivalence32 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "statement1",
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject"
+      ],
+      "Resource": 42
+    }
+  ]
+}`
+
+const testPolicyEquivalence33 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "statement1",
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject"
+      ],
+      "Resource": [42]
+    }
+  ]
+}`
@@ -34,7 +34,11 @@ func ResourceGroupPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentPolicyDiffs,
+			},
+			"policy_diff": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -55,6 +59,8 @@ func ResourceGroupPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: setPolicyDiff,
 	}
 }
 
@@ -142,6 +148,11 @@ func resourceGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting policy: %s", err)
 	}
 
+	// The policy difference is only shown in plans.
+	if err := d.Set("policy_diff", ""); err != nil {
+		return fmt.Errorf("error setting policy_diff: %s", err)
+	}
+
 	if err := d.Set("name", name); err != nil {
 		return fmt.Errorf("error setting name: %s", err)
 	}
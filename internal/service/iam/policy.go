@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,7 +45,11 @@ func ResourcePolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentPolicyDiffs,
+			},
+			"policy_diff": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -79,7 +84,10 @@ func ResourcePolicy() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			setPolicyDiff,
+		),
 	}
 }
 
@@ -228,6 +236,8 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("policy", policyDocument)
+	// The policy difference is only shown in plans.
+	d.Set("policy_diff", "")
 
 	return nil
 }
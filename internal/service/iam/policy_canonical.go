@@ -0,0 +1,445 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	policyEffectAllow = "Allow"
+	policyEffectDeny  = "Deny"
+)
+
+var accountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+
+// CanonicalPolicyDoc is a normalised form of an IAM policy document in which
+// semantically equivalent documents have identical representations:
+//   - statements are sorted
+//   - single values and single element lists are both represented as lists
+//   - list values are sorted and de-duplicated
+//   - Effect is case insensitive
+//   - Principal "*" is equivalent to {"AWS": "*"}
+//   - an AWS account ID principal is equivalent to the account's root user ARN
+//   - actions are lower-cased, as IAM actions are case insensitive
+//   - condition keys are lower-cased, as condition keys are case insensitive
+type CanonicalPolicyDoc struct {
+	Version    string
+	Id         string
+	Statements []*CanonicalPolicyStatement
+}
+
+type CanonicalPolicyStatement struct {
+	Sid           string
+	Effect        string
+	Actions       []string
+	NotActions    []string
+	Resources     []string
+	NotResources  []string
+	Principals    map[string][]string
+	NotPrincipals map[string][]string
+	// Conditions is keyed by condition operator, then by lower-cased condition key.
+	Conditions map[string]map[string][]string
+}
+
+// CanonicalizePolicy parses the specified IAM policy JSON into its canonical form.
+func CanonicalizePolicy(policy string) (*CanonicalPolicyDoc, error) {
+	doc, err := parseCanonicalPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range doc.Statements {
+		s.Effect = canonicalEffect(s.Effect)
+	}
+
+	sort.SliceStable(doc.Statements, func(i, j int) bool {
+		si, sj := doc.Statements[i], doc.Statements[j]
+		if si.Sid != sj.Sid {
+			return si.Sid < sj.Sid
+		}
+		return si.String() < sj.String()
+	})
+
+	return doc, nil
+}
+
+// parseCanonicalPolicy parses the specified IAM policy JSON, canonicalising
+// each statement but retaining the document's statement order.
+func parseCanonicalPolicy(policy string) (*CanonicalPolicyDoc, error) {
+	var raw map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("error parsing policy: %w", err)
+	}
+
+	doc := &CanonicalPolicyDoc{}
+
+	for k, v := range raw {
+		switch k {
+		case "Version":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("error parsing policy: Version: expected string, got %T", v)
+			}
+			doc.Version = s
+		case "Id":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("error parsing policy: Id: expected string, got %T", v)
+			}
+			doc.Id = s
+		case "Statement":
+			var statements []interface{}
+
+			switch v := v.(type) {
+			case map[string]interface{}:
+				statements = []interface{}{v}
+			case []interface{}:
+				statements = v
+			default:
+				return nil, fmt.Errorf("error parsing policy: Statement: expected object or array, got %T", v)
+			}
+
+			for i, s := range statements {
+				m, ok := s.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("error parsing policy: Statement[%d]: expected object, got %T", i, s)
+				}
+
+				statement, err := canonicalizePolicyStatement(m)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing policy: Statement[%d]: %w", i, err)
+				}
+
+				doc.Statements = append(doc.Statements, statement)
+			}
+		default:
+			return nil, fmt.Errorf("error parsing policy: unsupported element %q", k)
+		}
+	}
+
+	if _, ok := raw["Statement"]; !ok {
+		return nil, fmt.Errorf("error parsing policy: missing Statement")
+	}
+
+	return doc, nil
+}
+
+// PoliciesAreEquivalent returns whether or not the two IAM policy JSON documents
+// are semantically equivalent.
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	doc1, err := CanonicalizePolicy(policy1)
+	if err != nil {
+		return false, err
+	}
+
+	doc2, err := CanonicalizePolicy(policy2)
+	if err != nil {
+		return false, err
+	}
+
+	return doc1.String() == doc2.String(), nil
+}
+
+// String returns the canonical JSON representation of the policy document.
+// Single element lists are collapsed to scalar values.
+func (d *CanonicalPolicyDoc) String() string {
+	raw := map[string]interface{}{}
+
+	if d.Version != "" {
+		raw["Version"] = d.Version
+	}
+	if d.Id != "" {
+		raw["Id"] = d.Id
+	}
+
+	statements := make([]interface{}, 0, len(d.Statements))
+	for _, s := range d.Statements {
+		statements = append(statements, s.raw())
+	}
+	raw["Statement"] = statements
+
+	b, _ := json.Marshal(raw)
+
+	return string(b)
+}
+
+// String returns the canonical JSON representation of the policy statement.
+func (s *CanonicalPolicyStatement) String() string {
+	b, _ := json.Marshal(s.raw())
+
+	return string(b)
+}
+
+func (s *CanonicalPolicyStatement) raw() map[string]interface{} {
+	raw := map[string]interface{}{}
+
+	if s.Sid != "" {
+		raw["Sid"] = s.Sid
+	}
+	if s.Effect != "" {
+		raw["Effect"] = s.Effect
+	}
+
+	for k, v := range map[string][]string{
+		"Action":      s.Actions,
+		"NotAction":   s.NotActions,
+		"Resource":    s.Resources,
+		"NotResource": s.NotResources,
+	} {
+		if len(v) > 0 {
+			raw[k] = collapseStringList(v)
+		}
+	}
+
+	for k, v := range map[string]map[string][]string{
+		"Principal":    s.Principals,
+		"NotPrincipal": s.NotPrincipals,
+	} {
+		if len(v) > 0 {
+			m := map[string]interface{}{}
+			for t, ids := range v {
+				m[t] = collapseStringList(ids)
+			}
+			raw[k] = m
+		}
+	}
+
+	if len(s.Conditions) > 0 {
+		m := map[string]interface{}{}
+		for op, keys := range s.Conditions {
+			mk := map[string]interface{}{}
+			for k, values := range keys {
+				mk[k] = collapseStringList(values)
+			}
+			m[op] = mk
+		}
+		raw["Condition"] = m
+	}
+
+	return raw
+}
+
+func canonicalizePolicyStatement(m map[string]interface{}) (*CanonicalPolicyStatement, error) {
+	statement := &CanonicalPolicyStatement{}
+
+	for k, v := range m {
+		var err error
+
+		switch k {
+		case "Sid":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("Sid: expected string, got %T", v)
+			}
+			statement.Sid = s
+		case "Effect":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("Effect: expected string, got %T", v)
+			}
+			statement.Effect = s
+		case "Action":
+			statement.Actions, err = canonicalStringList(v, strings.ToLower)
+		case "NotAction":
+			statement.NotActions, err = canonicalStringList(v, strings.ToLower)
+		case "Resource":
+			statement.Resources, err = canonicalStringList(v, nil)
+		case "NotResource":
+			statement.NotResources, err = canonicalStringList(v, nil)
+		case "Principal":
+			statement.Principals, err = canonicalPrincipals(v)
+		case "NotPrincipal":
+			statement.NotPrincipals, err = canonicalPrincipals(v)
+		case "Condition":
+			statement.Conditions, err = canonicalConditions(v)
+		default:
+			return nil, fmt.Errorf("unsupported element %q", k)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return statement, nil
+}
+
+func canonicalStringList(v interface{}, f func(string) string) ([]string, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case string:
+		values = []string{v}
+	case []interface{}:
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected string, got %T", e)
+			}
+			values = append(values, s)
+		}
+	default:
+		return nil, fmt.Errorf("expected string or array, got %T", v)
+	}
+
+	if f != nil {
+		for i, s := range values {
+			values[i] = f(s)
+		}
+	}
+
+	return sortedUniqueStrings(values), nil
+}
+
+func canonicalPrincipals(v interface{}) (map[string][]string, error) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf("expected \"*\" or object, got %q", v)
+		}
+		return map[string][]string{"AWS": {"*"}}, nil
+	case map[string]interface{}:
+		principals := map[string][]string{}
+		for t, ids := range v {
+			var f func(string) string
+			if t == "AWS" {
+				f = canonicalAWSPrincipal
+			}
+
+			values, err := canonicalStringList(ids, f)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", t, err)
+			}
+
+			// An empty set of principals has the same effect as omitting the type.
+			if len(values) == 0 {
+				continue
+			}
+
+			principals[t] = values
+		}
+		return principals, nil
+	default:
+		return nil, fmt.Errorf("expected \"*\" or object, got %T", v)
+	}
+}
+
+// canonicalEffect returns the canonical casing of the specified statement Effect.
+func canonicalEffect(effect string) string {
+	for _, v := range []string{policyEffectAllow, policyEffectDeny} {
+		if strings.EqualFold(effect, v) {
+			return v
+		}
+	}
+
+	return effect
+}
+
+// canonicalAWSPrincipal returns the canonical form of the specified AWS principal.
+// IAM converts an account ID principal to the account's root user ARN,
+// so root user ARNs are canonicalised to the account ID.
+func canonicalAWSPrincipal(principal string) string {
+	v, err := arn.Parse(principal)
+	if err != nil {
+		return principal
+	}
+
+	if v.Service == "iam" && v.Resource == "root" && accountIDRegexp.MatchString(v.AccountID) {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+func canonicalConditions(v interface{}) (map[string]map[string][]string, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected object, got %T", v)
+	}
+
+	conditions := map[string]map[string][]string{}
+	for op, keys := range m {
+		mk, ok := keys.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: expected object, got %T", op, keys)
+		}
+
+		canonicalKeys := map[string][]string{}
+		for k, values := range mk {
+			var list []string
+			var err error
+
+			switch values := values.(type) {
+			case []interface{}:
+				for _, v := range values {
+					var s string
+					s, err = conditionValueString(v)
+					if err != nil {
+						break
+					}
+					list = append(list, s)
+				}
+			default:
+				var s string
+				s, err = conditionValueString(values)
+				list = []string{s}
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", op, k, err)
+			}
+
+			k = strings.ToLower(k)
+			canonicalKeys[k] = sortedUniqueStrings(append(canonicalKeys[k], list...))
+		}
+		conditions[op] = canonicalKeys
+	}
+
+	return conditions, nil
+}
+
+// conditionValueString returns the string form of a JSON scalar.
+// Condition values may be strings, numbers or booleans.
+func conditionValueString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("expected string, number or boolean, got %T", v)
+	}
+}
+
+func collapseStringList(values []string) interface{} {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
+
+func sortedUniqueStrings(values []string) []string {
+	if len(values) == 0 {
+		return values
+	}
+
+	sort.Strings(values)
+
+	out := values[:1]
+	for _, v := range values[1:] {
+		if v != out[len(out)-1] {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,221 @@
+package iam_test
+
+import (
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestPoliciesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Policy1    string
+		Policy2    string
+		Equivalent bool
+	}{
+		{
+			Name: "identical",
+			Policy1: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]
+}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single statement object",
+			Policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "action case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:getobject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "resource case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Bucket/*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "list ordering and duplicates",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name: "statement ordering",
+			Policy1: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},
+  {"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}
+]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},
+  {"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}
+]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "wildcard principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "principal list",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "account ID principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "account ID principal list",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws-us-gov:iam::111122223333:root","123456789012"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","111122223333"]},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different account ID principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "account ID principal and role ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "service principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "effect case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "condition key case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:sourcevpc":["vpc-1"]}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "condition value case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"VPC-1"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "boolean condition value",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "effect",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "version",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			equivalent, err := tfiam.PoliciesAreEquivalent(testCase.Policy1, testCase.Policy2)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if equivalent != testCase.Equivalent {
+				t.Errorf("got %t, expected %t", equivalent, testCase.Equivalent)
+			}
+		})
+	}
+}
+
+func TestCanonicalizePolicy(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy      string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:     "collapse single element lists",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":["S3:GetObject"],"Resource":["*"],"Principal":"*"}]}`,
+			Expected: `{"Statement":[{"Action":"s3:getobject","Effect":"Allow","Principal":{"AWS":"*"},"Resource":"*","Sid":"A"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name:     "sort statements by sid",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Action":"s3:getobject","Effect":"Allow","Resource":"*","Sid":"A"},{"Action":"s3:putobject","Effect":"Allow","Resource":"*","Sid":"B"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name:     "merge condition keys differing only in case",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-2","aws:sourcevpc":"vpc-1"}}}]}`,
+			Expected: `{"Statement":[{"Action":"s3:getobject","Condition":{"StringEquals":{"aws:sourcevpc":["vpc-1","vpc-2"]}},"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name:     "root user principal",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:user/test"]},"Action":"sts:AssumeRole"}]}`,
+			Expected: `{"Statement":[{"Action":"sts:assumerole","Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:user/test"]}}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name:        "invalid JSON",
+			Policy:      `{`,
+			ExpectError: true,
+		},
+		{
+			Name:        "unsupported element",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Actions":"s3:GetObject"}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid principal",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"arn:aws:iam::123456789012:root","Action":"sts:AssumeRole"}]}`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc, err := tfiam.CanonicalizePolicy(testCase.Policy)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := doc.String(); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,390 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PolicyDiff is the semantic difference between two IAM policy documents.
+type PolicyDiff struct {
+	// Changes lists differences in document level elements such as Version.
+	Changes    []*PolicyElementChange
+	Statements []*PolicyStatementDiff
+}
+
+// PolicyStatementDiff describes how a single statement differs between two policy documents.
+// A statement present only in the new document is Added and one present only in
+// the old document is Removed; otherwise Changes lists the per-element differences.
+type PolicyStatementDiff struct {
+	Sid     string
+	Effect  string
+	Added   bool
+	Removed bool
+	Changes []*PolicyElementChange
+}
+
+// PolicyElementChange lists the values added to and removed from a statement element
+// such as Action, Resource, Principal or Condition.
+type PolicyElementChange struct {
+	Element string
+	Added   []string
+	Removed []string
+}
+
+// DiffPolicies returns the semantic difference between two IAM policy JSON documents.
+// Statements are matched by Sid, or for statements without a Sid by content.
+func DiffPolicies(oldPolicy, newPolicy string) (*PolicyDiff, error) {
+	oldDoc, err := CanonicalizePolicy(oldPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	newDoc, err := CanonicalizePolicy(newPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffCanonicalPolicies(oldDoc, newDoc), nil
+}
+
+// IsEmpty returns whether or not the policy documents are semantically equivalent.
+func (d *PolicyDiff) IsEmpty() bool {
+	return d == nil || (len(d.Changes) == 0 && len(d.Statements) == 0)
+}
+
+// String returns a human-readable representation of the difference, for example:
+//
+//	~ Statement "ReadOnly" (Allow)
+//	    + Action: s3:getobject
+//	    - Resource: arn:aws:s3:::example/*
+func (d *PolicyDiff) String() string {
+	if d.IsEmpty() {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if len(d.Changes) > 0 {
+		sb.WriteString("~ Document\n")
+		writePolicyElementChanges(&sb, d.Changes)
+	}
+
+	for _, s := range d.Statements {
+		symbol := "~"
+		if s.Added {
+			symbol = "+"
+		} else if s.Removed {
+			symbol = "-"
+		}
+
+		fmt.Fprintf(&sb, "%s %s (%s)\n", symbol, s.label(), s.Effect)
+		writePolicyElementChanges(&sb, s.Changes)
+	}
+
+	return sb.String()
+}
+
+func writePolicyElementChanges(sb *strings.Builder, changes []*PolicyElementChange) {
+	for _, c := range changes {
+		for _, v := range c.Removed {
+			fmt.Fprintf(sb, "    - %s: %s\n", c.Element, v)
+		}
+		for _, v := range c.Added {
+			fmt.Fprintf(sb, "    + %s: %s\n", c.Element, v)
+		}
+	}
+}
+
+func (s *PolicyStatementDiff) label() string {
+	if s.Sid != "" {
+		return fmt.Sprintf("Statement %q", s.Sid)
+	}
+	return "Statement"
+}
+
+func diffCanonicalPolicies(oldDoc, newDoc *CanonicalPolicyDoc) *PolicyDiff {
+	diff := &PolicyDiff{}
+
+	if c := diffStringSets("Version", nonEmpty(oldDoc.Version), nonEmpty(newDoc.Version)); c != nil {
+		diff.Changes = append(diff.Changes, c)
+	}
+	if c := diffStringSets("Id", nonEmpty(oldDoc.Id), nonEmpty(newDoc.Id)); c != nil {
+		diff.Changes = append(diff.Changes, c)
+	}
+
+	var oldUnmatched, newUnmatched []*CanonicalPolicyStatement
+
+	// Statements with a Sid are matched by Sid.
+	oldBySid := map[string]*CanonicalPolicyStatement{}
+	for _, s := range oldDoc.Statements {
+		if s.Sid == "" {
+			oldUnmatched = append(oldUnmatched, s)
+			continue
+		}
+		oldBySid[s.Sid] = s
+	}
+
+	for _, s := range newDoc.Statements {
+		if s.Sid == "" {
+			newUnmatched = append(newUnmatched, s)
+			continue
+		}
+		if old, ok := oldBySid[s.Sid]; ok {
+			delete(oldBySid, s.Sid)
+			if sd := diffCanonicalStatements(old, s); sd != nil {
+				diff.Statements = append(diff.Statements, sd)
+			}
+			continue
+		}
+		newUnmatched = append(newUnmatched, s)
+	}
+
+	for _, s := range oldDoc.Statements {
+		if _, ok := oldBySid[s.Sid]; ok && s.Sid != "" {
+			oldUnmatched = append(oldUnmatched, s)
+		}
+	}
+
+	// Identical statements are unchanged.
+	oldUnmatched, newUnmatched = removeIdenticalStatements(oldUnmatched, newUnmatched)
+
+	// Remaining statements are paired by greatest similarity.
+	for len(oldUnmatched) > 0 && len(newUnmatched) > 0 {
+		bestScore, bestOld, bestNew := 0, -1, -1
+
+		for i, o := range oldUnmatched {
+			for j, n := range newUnmatched {
+				if score := statementSimilarity(o, n); score > bestScore {
+					bestScore, bestOld, bestNew = score, i, j
+				}
+			}
+		}
+
+		if bestScore == 0 {
+			break
+		}
+
+		if sd := diffCanonicalStatements(oldUnmatched[bestOld], newUnmatched[bestNew]); sd != nil {
+			diff.Statements = append(diff.Statements, sd)
+		}
+
+		oldUnmatched = append(oldUnmatched[:bestOld], oldUnmatched[bestOld+1:]...)
+		newUnmatched = append(newUnmatched[:bestNew], newUnmatched[bestNew+1:]...)
+	}
+
+	for _, s := range oldUnmatched {
+		diff.Statements = append(diff.Statements, statementAsDiff(s, true))
+	}
+
+	for _, s := range newUnmatched {
+		diff.Statements = append(diff.Statements, statementAsDiff(s, false))
+	}
+
+	return diff
+}
+
+func diffCanonicalStatements(oldStatement, newStatement *CanonicalPolicyStatement) *PolicyStatementDiff {
+	sd := &PolicyStatementDiff{
+		Sid:    newStatement.Sid,
+		Effect: newStatement.Effect,
+	}
+
+	oldElements, newElements := oldStatement.elements(), newStatement.elements()
+
+	for _, element := range policyStatementElements {
+		if c := diffStringSets(element, oldElements[element], newElements[element]); c != nil {
+			sd.Changes = append(sd.Changes, c)
+		}
+	}
+
+	if len(sd.Changes) == 0 {
+		return nil
+	}
+
+	return sd
+}
+
+// statementAsDiff returns the diff for a statement that is present in only one document.
+func statementAsDiff(statement *CanonicalPolicyStatement, removed bool) *PolicyStatementDiff {
+	sd := &PolicyStatementDiff{
+		Sid:     statement.Sid,
+		Effect:  statement.Effect,
+		Added:   !removed,
+		Removed: removed,
+	}
+
+	elements := statement.elements()
+
+	for _, element := range policyStatementElements {
+		if element == "Effect" || len(elements[element]) == 0 {
+			continue
+		}
+
+		c := &PolicyElementChange{Element: element}
+		if removed {
+			c.Removed = elements[element]
+		} else {
+			c.Added = elements[element]
+		}
+		sd.Changes = append(sd.Changes, c)
+	}
+
+	return sd
+}
+
+var policyStatementElements = []string{
+	"Effect",
+	"Principal",
+	"NotPrincipal",
+	"Action",
+	"NotAction",
+	"Resource",
+	"NotResource",
+	"Condition",
+}
+
+// elements returns the flattened values of each statement element.
+// Principals are represented as "Type:Identifier" and conditions as "Operator Key=Value".
+func (s *CanonicalPolicyStatement) elements() map[string][]string {
+	elements := map[string][]string{
+		"Effect":      nonEmpty(s.Effect),
+		"Action":      s.Actions,
+		"NotAction":   s.NotActions,
+		"Resource":    s.Resources,
+		"NotResource": s.NotResources,
+	}
+
+	for element, principals := range map[string]map[string][]string{
+		"Principal":    s.Principals,
+		"NotPrincipal": s.NotPrincipals,
+	} {
+		var values []string
+		for t, ids := range principals {
+			for _, id := range ids {
+				values = append(values, t+":"+id)
+			}
+		}
+		sort.Strings(values)
+		elements[element] = values
+	}
+
+	var conditions []string
+	for op, keys := range s.Conditions {
+		for k, values := range keys {
+			for _, v := range values {
+				conditions = append(conditions, fmt.Sprintf("%s %s=%s", op, k, v))
+			}
+		}
+	}
+	sort.Strings(conditions)
+	elements["Condition"] = conditions
+
+	return elements
+}
+
+func statementSimilarity(s1, s2 *CanonicalPolicyStatement) int {
+	if s1.Effect != s2.Effect {
+		return 0
+	}
+
+	score := 0
+	e1, e2 := s1.elements(), s2.elements()
+
+	for _, element := range policyStatementElements {
+		set := map[string]bool{}
+		for _, v := range e1[element] {
+			set[v] = true
+		}
+		for _, v := range e2[element] {
+			if set[v] {
+				score++
+			}
+		}
+	}
+
+	return score
+}
+
+func removeIdenticalStatements(oldStatements, newStatements []*CanonicalPolicyStatement) ([]*CanonicalPolicyStatement, []*CanonicalPolicyStatement) {
+	var oldOut []*CanonicalPolicyStatement
+
+	for _, o := range oldStatements {
+		matched := false
+		for j, n := range newStatements {
+			if o.String() == n.String() {
+				newStatements = append(newStatements[:j], newStatements[j+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			oldOut = append(oldOut, o)
+		}
+	}
+
+	return oldOut, newStatements
+}
+
+func diffStringSets(element string, oldValues, newValues []string) *PolicyElementChange {
+	oldSet, newSet := map[string]bool{}, map[string]bool{}
+	for _, v := range oldValues {
+		oldSet[v] = true
+	}
+	for _, v := range newValues {
+		newSet[v] = true
+	}
+
+	c := &PolicyElementChange{Element: element}
+	for _, v := range newValues {
+		if !oldSet[v] {
+			c.Added = append(c.Added, v)
+		}
+	}
+	for _, v := range oldValues {
+		if !newSet[v] {
+			c.Removed = append(c.Removed, v)
+		}
+	}
+
+	if len(c.Added) == 0 && len(c.Removed) == 0 {
+		return nil
+	}
+
+	sort.Strings(c.Added)
+	sort.Strings(c.Removed)
+
+	return c
+}
+
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+// setPolicyDiff sets policy_diff to the semantic difference between the current
+// and planned policy documents so that plans show which permissions change.
+func setPolicyDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("policy") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("policy") {
+		return diff.SetNewComputed("policy_diff")
+	}
+
+	o, n := diff.GetChange("policy")
+	policyDiff, err := DiffPolicies(o.(string), n.(string))
+
+	// Documents that cannot be canonicalized are only shown as a change to policy.
+	if err != nil {
+		return nil
+	}
+
+	return diff.SetNew("policy_diff", policyDiff.String())
+}
@@ -0,0 +1,162 @@
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestDiffPolicies(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		OldPolicy string
+		NewPolicy string
+		Expected  string
+	}{
+		{
+			Name:      "equivalent",
+			OldPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			NewPolicy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["S3:GetObject"],"Resource":["*"]}}`,
+			Expected:  "",
+		},
+		{
+			Name:      "added action by sid",
+			OldPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			NewPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::example/*"}]}`,
+			Expected: `~ Statement "Read" (Allow)
+    + Action: s3:listbucket
+`,
+		},
+		{
+			Name: "changed statement without sid",
+			OldPolicy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},
+  {"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::old/*"}
+]}`,
+			NewPolicy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::new/*"},
+  {"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}
+]}`,
+			Expected: `~ Statement (Allow)
+    - Resource: arn:aws:s3:::old/*
+    + Resource: arn:aws:s3:::new/*
+`,
+		},
+		{
+			Name:      "added and removed statements",
+			OldPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"Old","Effect":"Allow","Action":"ec2:DescribeInstances","Resource":"*"}]}`,
+			NewPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"New","Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Expected: `- Statement "Old" (Allow)
+    - Action: ec2:describeinstances
+    - Resource: *
++ Statement "New" (Deny)
+    + Principal: AWS:123456789012
+    + Action: s3:*
+    + Resource: *
+    + Condition: Bool aws:securetransport=false
+`,
+		},
+		{
+			Name:      "effect and condition",
+			OldPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			NewPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-2"}}}]}`,
+			Expected: `~ Statement "A" (Deny)
+    - Effect: Allow
+    + Effect: Deny
+    - Condition: StringEquals aws:sourcevpc=vpc-1
+    + Condition: StringEquals aws:sourcevpc=vpc-2
+`,
+		},
+		{
+			Name:      "version",
+			OldPolicy: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			NewPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Expected: `~ Document
+    - Version: 2008-10-17
+    + Version: 2012-10-17
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diff, err := tfiam.DiffPolicies(testCase.OldPolicy, testCase.NewPolicy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := diff.String(); got != testCase.Expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", got, testCase.Expected)
+			}
+
+			if got, expected := diff.IsEmpty(), testCase.Expected == ""; got != expected {
+				t.Errorf("got IsEmpty %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestRolePolicyPolicyDiff(t *testing.T) {
+	oldPolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
+	testCases := []struct {
+		Name      string
+		NewPolicy string
+		Expected  string
+	}{
+		{
+			Name:      "equivalent",
+			NewPolicy: `{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":["S3:GetObject"],"Resource":["*"]}}`,
+		},
+		{
+			Name:      "added action",
+			NewPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`,
+			Expected: `~ Statement "Read" (Allow)
+    + Action: s3:listbucket
+`,
+		},
+		{
+			Name:      "unsupported element",
+			NewPolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Unsupported":true}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfiam.ResourceRolePolicy()
+			state := &terraform.InstanceState{
+				ID: "role:policy",
+				Attributes: map[string]string{
+					"id":          "role:policy",
+					"name":        "policy",
+					"policy":      oldPolicy,
+					"policy_diff": "",
+					"role":        "role",
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":   "policy",
+				"policy": testCase.NewPolicy,
+				"role":   "role",
+			})
+
+			diff, err := r.Diff(context.Background(), state, config, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got string
+			if diff != nil && diff.Attributes["policy_diff"] != nil {
+				got = diff.Attributes["policy_diff"].New
+			}
+
+			if expected := testCase.Expected; got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
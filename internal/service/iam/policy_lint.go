@@ -0,0 +1,595 @@
+package iam
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Policy lint finding severities, ordered from most to least severe.
+// These mirror the finding types returned by IAM Access Analyzer policy validation.
+const (
+	PolicyLintSeverityError           = "ERROR"
+	PolicyLintSeveritySecurityWarning = "SECURITY_WARNING"
+	PolicyLintSeverityWarning         = "WARNING"
+	PolicyLintSeveritySuggestion      = "SUGGESTION"
+)
+
+func PolicyLintSeverity_Values() []string {
+	return []string{
+		PolicyLintSeverityError,
+		PolicyLintSeveritySecurityWarning,
+		PolicyLintSeverityWarning,
+		PolicyLintSeveritySuggestion,
+	}
+}
+
+// Policy lint finding codes.
+const (
+	PolicyLintCodeDuplicateSid              = "DUPLICATE_SID"
+	PolicyLintCodeInvalidEffect             = "INVALID_EFFECT"
+	PolicyLintCodeInvalidElement            = "INVALID_ELEMENT"
+	PolicyLintCodeMalformedARN              = "MALFORMED_ARN"
+	PolicyLintCodeMissingVersion            = "MISSING_VERSION"
+	PolicyLintCodeNotActionWithAllow        = "NOT_ACTION_WITH_ALLOW"
+	PolicyLintCodeNotResourceWithAllow      = "NOT_RESOURCE_WITH_ALLOW"
+	PolicyLintCodeUnknownConditionOperator  = "UNKNOWN_CONDITION_OPERATOR"
+	PolicyLintCodeUnsupportedElement        = "UNSUPPORTED_ELEMENT"
+	PolicyLintCodeWildcardActionAndResource = "WILDCARD_ACTION_AND_RESOURCE"
+	PolicyLintCodeWildcardPrincipal         = "WILDCARD_PRINCIPAL"
+)
+
+// PolicyLintFinding is a single issue found in an IAM policy document.
+// StatementIndex is the zero-based index of the statement in the document,
+// or -1 for document level findings.
+type PolicyLintFinding struct {
+	Code           string
+	Severity       string
+	StatementIndex int
+	Sid            string
+	Message        string
+}
+
+// conditionOperators is the set of IAM condition operators, excluding the
+// "IfExists" suffix and "ForAllValues:"/"ForAnyValue:" set operator prefixes.
+var conditionOperators = map[string]bool{
+	"ArnEquals":                 true,
+	"ArnLike":                   true,
+	"ArnNotEquals":              true,
+	"ArnNotLike":                true,
+	"BinaryEquals":              true,
+	"Bool":                      true,
+	"DateEquals":                true,
+	"DateGreaterThan":           true,
+	"DateGreaterThanEquals":     true,
+	"DateLessThan":              true,
+	"DateLessThanEquals":        true,
+	"DateNotEquals":             true,
+	"IpAddress":                 true,
+	"NotIpAddress":              true,
+	"Null":                      true,
+	"NumericEquals":             true,
+	"NumericGreaterThan":        true,
+	"NumericGreaterThanEquals":  true,
+	"NumericLessThan":           true,
+	"NumericLessThanEquals":     true,
+	"NumericNotEquals":          true,
+	"StringEquals":              true,
+	"StringEqualsIgnoreCase":    true,
+	"StringLike":                true,
+	"StringNotEquals":           true,
+	"StringNotEqualsIgnoreCase": true,
+	"StringNotLike":             true,
+}
+
+// conditionOperator is a parsed IAM condition operator.
+type conditionOperator struct {
+	// Name is the base operator, e.g. "StringLike" for "ForAnyValue:StringLikeIfExists".
+	Name string
+	// SetQualifier is "ForAllValues", "ForAnyValue" or empty.
+	SetQualifier string
+	IfExists     bool
+}
+
+// parseConditionOperator parses the specified condition operator and returns
+// whether or not it is a known operator.
+func parseConditionOperator(s string) (conditionOperator, bool) {
+	var op conditionOperator
+
+	if i := strings.Index(s, ":"); i >= 0 {
+		op.SetQualifier = s[:i]
+		s = s[i+1:]
+
+		if op.SetQualifier != "ForAllValues" && op.SetQualifier != "ForAnyValue" {
+			return op, false
+		}
+	}
+
+	if strings.HasSuffix(s, "IfExists") && s != "IfExists" {
+		op.IfExists = true
+		s = strings.TrimSuffix(s, "IfExists")
+	}
+
+	op.Name = s
+
+	if !conditionOperators[s] {
+		return op, false
+	}
+
+	// "Null" checks for key existence so cannot be combined with "IfExists".
+	if op.Name == "Null" && op.IfExists {
+		return op, false
+	}
+
+	return op, true
+}
+
+var (
+	policyVariableRegexp = regexp.MustCompile(`\$\{[^}]*\}`)
+	accountIDOnlyRegexp  = regexp.MustCompile(`^\d{12}$`)
+)
+
+// validPolicyARN validates an ARN used in a policy document, which may contain
+// wildcards and policy variables in the region, account ID and resource segments.
+func validPolicyARN(v string) error {
+	if v == "*" || !strings.HasPrefix(v, "arn:") {
+		return fmt.Errorf("%q is not an ARN", v)
+	}
+
+	// Policy variables may themselves contain colons, e.g. ${aws:username}.
+	v = policyVariableRegexp.ReplaceAllString(v, "*")
+
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		_, errs := verify.ValidARN(v, "arn")
+		return errs[0]
+	}
+
+	// Substitute representative values for wildcard segments that the ARN
+	// validator would otherwise reject.
+	if strings.ContainsAny(parsedARN.Region, "*?") {
+		parsedARN.Region = "us-east-1" //lintignore:AWSAT003
+	}
+	if strings.ContainsAny(parsedARN.AccountID, "*?") {
+		parsedARN.AccountID = "123456789012"
+	}
+	if parsedARN.Partition == "*" {
+		parsedARN.Partition = "aws"
+	}
+
+	if _, errs := verify.ValidARN(parsedARN.String(), "arn"); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+var (
+	// uniqueIDPrincipalRegexp matches the unique IDs of IAM users and roles, which
+	// IAM substitutes for principal ARNs when the user or role is deleted.
+	uniqueIDPrincipalRegexp = regexp.MustCompile(`^A(IDA|ROA)[0-9A-Z]{13,}$`)
+	serviceWildcardRegexp   = regexp.MustCompile(`^[0-9A-Za-z-]+:\*$`)
+)
+
+// LintPolicy statically analyzes the specified IAM policy JSON document and
+// returns any findings. Unsupported or mistyped policy elements are returned as
+// findings. An error is returned only if the document is not a JSON object.
+func LintPolicy(policy string) ([]*PolicyLintFinding, error) {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("error parsing policy: %w", err)
+	}
+
+	var findings []*PolicyLintFinding
+
+	addDocumentFinding := func(code, severity, format string, a ...interface{}) {
+		findings = append(findings, &PolicyLintFinding{
+			Code:           code,
+			Severity:       severity,
+			StatementIndex: -1,
+			Message:        fmt.Sprintf(format, a...),
+		})
+	}
+
+	doc := &IAMPolicyDoc{}
+
+	for _, k := range policyLintKeys(raw) {
+		var err error
+
+		switch v := raw[k]; k {
+		case "Version":
+			doc.Version, err = decodePolicyLintString(v)
+		case "Id":
+			doc.Id, err = decodePolicyLintString(v)
+		case "Statement":
+		default:
+			addDocumentFinding(PolicyLintCodeUnsupportedElement, PolicyLintSeverityError, "policy element %q is not supported", k)
+		}
+
+		if err != nil {
+			addDocumentFinding(PolicyLintCodeInvalidElement, PolicyLintSeverityError, "policy element %q is invalid: %s", k, err)
+		}
+	}
+
+	if _, ok := raw["Version"]; !ok {
+		addDocumentFinding(PolicyLintCodeMissingVersion, PolicyLintSeverityWarning, `policy does not specify a Version; policy variables are not supported before version "2012-10-17"`)
+	}
+
+	var rawStatements []json.RawMessage
+
+	if v, ok := raw["Statement"]; !ok {
+		addDocumentFinding(PolicyLintCodeInvalidElement, PolicyLintSeverityError, "policy does not contain a Statement")
+	} else if err := json.Unmarshal(v, &rawStatements); err != nil {
+		// A policy containing a single statement may specify it as an object.
+		rawStatements = []json.RawMessage{v}
+	}
+
+	var statementFindings [][]*PolicyLintFinding
+
+	for _, v := range rawStatements {
+		s, f := decodePolicyLintStatement(v)
+		doc.Statements = append(doc.Statements, s)
+		statementFindings = append(statementFindings, f)
+	}
+
+	sids := map[string]int{}
+
+	for i, s := range doc.Statements {
+		for _, f := range statementFindings[i] {
+			f.StatementIndex = i
+			if s != nil {
+				f.Sid = s.Sid
+			}
+			findings = append(findings, f)
+		}
+
+		if s == nil {
+			continue
+		}
+
+		add := func(code, severity, format string, a ...interface{}) {
+			findings = append(findings, &PolicyLintFinding{
+				Code:           code,
+				Severity:       severity,
+				StatementIndex: i,
+				Sid:            s.Sid,
+				Message:        fmt.Sprintf(format, a...),
+			})
+		}
+
+		if s.Sid != "" {
+			if j, ok := sids[s.Sid]; ok {
+				add(PolicyLintCodeDuplicateSid, PolicyLintSeverityError, "Sid %q is also used by statement %d", s.Sid, j)
+			} else {
+				sids[s.Sid] = i
+			}
+		}
+
+		allow := s.Effect == "Allow"
+
+		if !allow && s.Effect != "Deny" {
+			add(PolicyLintCodeInvalidEffect, PolicyLintSeverityError, `Effect must be "Allow" or "Deny", got %q`, s.Effect)
+		}
+
+		if action, ok := wildcardAction(policyStatementStrings(s.Actions)); allow && ok && hasString(policyStatementStrings(s.Resources), "*") {
+			if action == "*" || action == "*:*" {
+				add(PolicyLintCodeWildcardActionAndResource, PolicyLintSeveritySecurityWarning, "statement allows all actions on all resources")
+			} else {
+				add(PolicyLintCodeWildcardActionAndResource, PolicyLintSeveritySecurityWarning, "statement allows all %s actions on all resources", strings.TrimSuffix(action, ":*"))
+			}
+		}
+
+		if allow && len(policyStatementStrings(s.NotActions)) > 0 {
+			add(PolicyLintCodeNotActionWithAllow, PolicyLintSeverityWarning, "statement uses NotAction with Allow, which grants all actions not listed, including those of services added in the future")
+		}
+
+		if allow && len(policyStatementStrings(s.NotResources)) > 0 {
+			add(PolicyLintCodeNotResourceWithAllow, PolicyLintSeverityWarning, "statement uses NotResource with Allow, which grants access to all resources not listed")
+		}
+
+		if allow && hasWildcardPrincipal(s.Principals) && len(s.Conditions) == 0 {
+			add(PolicyLintCodeWildcardPrincipal, PolicyLintSeveritySecurityWarning, "statement allows access to any principal without conditions")
+		}
+
+		for _, v := range append(policyStatementStrings(s.Resources), policyStatementStrings(s.NotResources)...) {
+			if v == "*" {
+				continue
+			}
+
+			if err := validPolicyARN(v); err != nil {
+				add(PolicyLintCodeMalformedARN, PolicyLintSeverityError, "resource %q is malformed: %s", v, err)
+			}
+		}
+
+		for _, principals := range []IAMPolicyStatementPrincipalSet{s.Principals, s.NotPrincipals} {
+			for _, p := range principals {
+				if p.Type != "AWS" {
+					continue
+				}
+
+				for _, v := range policyStatementStrings(p.Identifiers) {
+					if v == "*" || accountIDOnlyRegexp.MatchString(v) || uniqueIDPrincipalRegexp.MatchString(v) {
+						continue
+					}
+
+					if err := validPolicyARN(v); err != nil {
+						add(PolicyLintCodeMalformedARN, PolicyLintSeverityError, "principal %q is malformed: %s", v, err)
+					}
+				}
+			}
+		}
+
+		var ops []string
+		for _, c := range s.Conditions {
+			if !hasString(ops, c.Test) {
+				ops = append(ops, c.Test)
+			}
+		}
+		sort.Strings(ops)
+
+		for _, op := range ops {
+			if _, ok := parseConditionOperator(op); !ok {
+				add(PolicyLintCodeUnknownConditionOperator, PolicyLintSeverityError, "unknown condition operator %q", op)
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// decodePolicyLintStatement decodes the specified policy statement.
+// Elements that cannot be decoded are returned as findings and left unset in the statement.
+// No statement is returned if the statement is not an object.
+func decodePolicyLintStatement(b json.RawMessage) (*IAMPolicyStatement, []*PolicyLintFinding) {
+	s := &IAMPolicyStatement{}
+
+	var findings []*PolicyLintFinding
+
+	add := func(code, format string, a ...interface{}) {
+		findings = append(findings, &PolicyLintFinding{
+			Code:     code,
+			Severity: PolicyLintSeverityError,
+			Message:  fmt.Sprintf(format, a...),
+		})
+	}
+
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		add(PolicyLintCodeInvalidElement, "statement must be an object")
+
+		return nil, findings
+	}
+
+	for _, k := range policyLintKeys(raw) {
+		var err error
+
+		switch v := raw[k]; k {
+		case "Sid":
+			s.Sid, err = decodePolicyLintString(v)
+		case "Effect":
+			s.Effect, err = decodePolicyLintString(v)
+		case "Action":
+			s.Actions, err = decodePolicyLintStringList(v)
+		case "NotAction":
+			s.NotActions, err = decodePolicyLintStringList(v)
+		case "Resource":
+			s.Resources, err = decodePolicyLintStringList(v)
+		case "NotResource":
+			s.NotResources, err = decodePolicyLintStringList(v)
+		case "Principal":
+			s.Principals, err = decodePolicyLintPrincipals(v)
+		case "NotPrincipal":
+			s.NotPrincipals, err = decodePolicyLintPrincipals(v)
+		case "Condition":
+			s.Conditions, err = decodePolicyLintConditions(v)
+		default:
+			add(PolicyLintCodeUnsupportedElement, "statement element %q is not supported", k)
+		}
+
+		if err != nil {
+			add(PolicyLintCodeInvalidElement, "statement element %q is invalid: %s", k, err)
+		}
+	}
+
+	return s, findings
+}
+
+func decodePolicyLintString(b json.RawMessage) (string, error) {
+	var v string
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", errors.New("expected a string")
+	}
+
+	return v, nil
+}
+
+func decodePolicyLintStringList(b json.RawMessage) ([]string, error) {
+	if v, err := decodePolicyLintString(b); err == nil {
+		return []string{v}, nil
+	}
+
+	var v []string
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, errors.New("expected a string or list of strings")
+	}
+
+	return v, nil
+}
+
+// decodePolicyLintPrincipals decodes the specified Principal or NotPrincipal element.
+// The element's shape is checked before decoding, as the principal set unmarshaler
+// accepts any string as "*" and does not check the type of list elements.
+func decodePolicyLintPrincipals(b json.RawMessage) (IAMPolicyStatementPrincipalSet, error) {
+	if v, err := decodePolicyLintString(b); err == nil {
+		if v != "*" {
+			return nil, fmt.Errorf(`expected "*" or an object, got %q`, v)
+		}
+	} else {
+		var raw map[string]json.RawMessage
+
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return nil, errors.New(`expected "*" or an object`)
+		}
+
+		for _, k := range policyLintKeys(raw) {
+			if _, err := decodePolicyLintStringList(raw[k]); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+		}
+	}
+
+	var principals IAMPolicyStatementPrincipalSet
+
+	if err := json.Unmarshal(b, &principals); err != nil {
+		return nil, err
+	}
+
+	return principals, nil
+}
+
+// decodePolicyLintConditions decodes the specified Condition element.
+// Unlike the condition set unmarshaler, boolean and numeric values are retained.
+func decodePolicyLintConditions(b json.RawMessage) (IAMPolicyStatementConditionSet, error) {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, errors.New("expected an object of condition operators")
+	}
+
+	var conditions IAMPolicyStatementConditionSet
+
+	for _, test := range policyLintKeys(raw) {
+		var variables map[string]json.RawMessage
+
+		if err := json.Unmarshal(raw[test], &variables); err != nil {
+			return nil, fmt.Errorf("%s: expected an object of condition keys", test)
+		}
+
+		for _, variable := range policyLintKeys(variables) {
+			var v interface{}
+
+			if err := json.Unmarshal(variables[variable], &v); err != nil {
+				return nil, err
+			}
+
+			values, ok := policyLintConditionValues(v)
+
+			if !ok {
+				return nil, fmt.Errorf("%s: %s: expected a string, boolean, number or list of these", test, variable)
+			}
+
+			conditions = append(conditions, IAMPolicyStatementCondition{
+				Test:     test,
+				Variable: variable,
+				Values:   values,
+			})
+		}
+	}
+
+	return conditions, nil
+}
+
+func policyLintConditionValues(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case string, bool, float64:
+		return []string{fmt.Sprint(v)}, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, v := range v {
+			switch v.(type) {
+			case string, bool, float64:
+				values = append(values, fmt.Sprint(v))
+			default:
+				return nil, false
+			}
+		}
+
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// policyLintKeys returns the sorted keys of the specified map, so that findings are returned in a stable order.
+func policyLintKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// policyStatementStrings returns the string values of the specified policy statement element.
+func policyStatementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	default:
+		return nil
+	}
+}
+
+// policyLintSeverityRank returns the rank of the specified severity, where 0 is most severe.
+func policyLintSeverityRank(severity string) int {
+	for i, v := range PolicyLintSeverity_Values() {
+		if v == severity {
+			return i
+		}
+	}
+
+	return len(PolicyLintSeverity_Values())
+}
+
+// wildcardAction returns the broadest wildcard action, either all actions or
+// all actions of a service, e.g. "s3:*".
+func wildcardAction(actions []string) (string, bool) {
+	var service string
+
+	for _, v := range actions {
+		if v == "*" || v == "*:*" {
+			return v, true
+		}
+
+		if service == "" && serviceWildcardRegexp.MatchString(v) {
+			service = v
+		}
+	}
+
+	return service, service != ""
+}
+
+func hasWildcardPrincipal(principals IAMPolicyStatementPrincipalSet) bool {
+	for _, p := range principals {
+		if (p.Type == "*" || p.Type == "AWS") && hasString(policyStatementStrings(p.Identifiers), "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
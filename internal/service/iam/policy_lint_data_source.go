@@ -0,0 +1,116 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePolicyLint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyLintRead,
+
+		Schema: map[string]*schema.Schema{
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ignore_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyLintRead(d *schema.ResourceData, meta interface{}) error {
+	policy := d.Get("policy").(string)
+
+	findings, err := LintPolicy(policy)
+
+	if err != nil {
+		return fmt.Errorf("error linting IAM policy: %w", err)
+	}
+
+	ignoreCodes := map[string]bool{}
+	for _, v := range d.Get("ignore_codes").(*schema.Set).List() {
+		ignoreCodes[v.(string)] = true
+	}
+
+	var errorCount int
+	var maxSeverity string
+	var tfList []interface{}
+
+	for _, finding := range findings {
+		if ignoreCodes[finding.Code] {
+			continue
+		}
+
+		if finding.Severity == PolicyLintSeverityError {
+			errorCount++
+		}
+
+		if maxSeverity == "" || policyLintSeverityRank(finding.Severity) < policyLintSeverityRank(maxSeverity) {
+			maxSeverity = finding.Severity
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"code":            finding.Code,
+			"message":         finding.Message,
+			"severity":        finding.Severity,
+			"sid":             finding.Sid,
+			"statement_index": finding.StatementIndex,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policy)))
+	d.Set("error_count", errorCount)
+	d.Set("max_severity", maxSeverity)
+
+	if err := d.Set("findings", tfList); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+
+	return nil
+}
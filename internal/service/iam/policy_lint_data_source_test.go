@@ -0,0 +1,104 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyLintDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "error_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_severity", "ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "findings.*", map[string]string{
+						"code":            "WILDCARD_ACTION_AND_RESOURCE",
+						"severity":        "SECURITY_WARNING",
+						"sid":             "Admin",
+						"statement_index": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "findings.*", map[string]string{
+						"code":            "UNKNOWN_CONDITION_OPERATOR",
+						"severity":        "ERROR",
+						"sid":             "",
+						"statement_index": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyLintDataSource_ignoreCodes(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceIgnoreCodesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "error_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_severity", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyLintDataSourceConfig = `
+data "aws_iam_policy_lint" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "Admin"
+        Effect   = "Allow"
+        Action   = "*"
+        Resource = "*"
+      },
+      {
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+        Condition = {
+          StringEqual = {
+            "aws:SourceVpc" = "vpc-12345678"
+          }
+        }
+      },
+    ]
+  })
+}
+`
+
+const testAccPolicyLintDataSourceIgnoreCodesConfig = `
+data "aws_iam_policy_lint" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        NotAction = "iam:*"
+        Resource  = "*"
+      },
+    ]
+  })
+
+  ignore_codes = ["NOT_ACTION_WITH_ALLOW"]
+}
+`
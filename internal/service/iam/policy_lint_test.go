@@ -0,0 +1,209 @@
+package iam_test
+
+import (
+	"reflect"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestLintPolicy(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Policy        string
+		ExpectedCodes []string
+		ExpectError   bool
+	}{
+		{
+			Name:          "no findings",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "missing version",
+			Policy:        `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeMissingVersion},
+		},
+		{
+			Name:          "wildcard action and resource",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeWildcardActionAndResource},
+		},
+		{
+			Name:          "wildcard action and resource with deny",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "not action with allow",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeNotActionWithAllow},
+		},
+		{
+			Name:          "not resource with allow",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::secret/*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeNotResourceWithAllow},
+		},
+		{
+			Name:          "invalid effect",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeInvalidEffect},
+		},
+		{
+			Name: "duplicate sid",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},
+  {"Sid":"A","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}
+]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeDuplicateSid},
+		},
+		{
+			Name:          "malformed resource ARN",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeMalformedARN},
+		},
+		{
+			Name:          "malformed resource ARN region",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:StartInstances","Resource":"arn:aws:ec2:US-EAST-1:123456789012:instance/*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeMalformedARN},
+		},
+		{
+			Name:          "wildcard ARN segments",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:StartInstances","Resource":["arn:aws:ec2:*:*:instance/*","arn:aws:iam::${aws:PrincipalAccount}:user/${aws:username}"]}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "malformed principal ARN",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::12345:root"]},"Action":"sts:AssumeRole"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeMalformedARN},
+		},
+		{
+			Name:          "wildcard principal",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeWildcardPrincipal},
+		},
+		{
+			Name:          "wildcard principal with condition",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-123"}}}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "known condition operators",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"ForAnyValue:StringLikeIfExists":{"aws:TagKeys":"a*"},"Null":{"aws:TokenIssueTime":"true"},"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "unknown condition operators",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:SourceVpc":"vpc-1"},"ForSomeValues:StringLike":{"aws:TagKeys":"a*"},"NullIfExists":{"aws:TokenIssueTime":"true"}}}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeUnknownConditionOperator, tfiam.PolicyLintCodeUnknownConditionOperator, tfiam.PolicyLintCodeUnknownConditionOperator},
+		},
+		{
+			Name:          "service wildcard action and resource",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","IAM:*"],"Resource":"*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeWildcardActionAndResource},
+		},
+		{
+			Name:          "service wildcard action with specific resource",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::example/*"}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "unique ID principals",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["AROAJQABLZS4A3QDU576Q","AIDACKCEVSQ6C2EXAMPLE"]},"Action":"sts:AssumeRole"}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "service principal",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "single statement object",
+			Policy:        `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "boolean and numeric condition values",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true},"NumericLessThan":{"s3:max-keys":[10,"20"]}}}]}`,
+			ExpectedCodes: nil,
+		},
+		{
+			Name:          "unsupported elements",
+			Policy:        `{"Version":"2012-10-17","Statements":[],"Statement":[{"Effect":"Allow","Actions":"s3:GetObject","Resource":"*"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeUnsupportedElement, tfiam.PolicyLintCodeUnsupportedElement},
+		},
+		{
+			Name:          "invalid elements",
+			Policy:        `{"Version":2012,"Statement":[{"Effect":"Allow","Action":1,"Principal":"arn:aws:iam::123456789012:root","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":{"vpc":"vpc-1"}}}}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeInvalidElement, tfiam.PolicyLintCodeInvalidElement, tfiam.PolicyLintCodeInvalidElement, tfiam.PolicyLintCodeInvalidElement},
+		},
+		{
+			Name:          "invalid principal list element",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012",1]},"Action":"sts:AssumeRole"}]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeInvalidElement},
+		},
+		{
+			Name:          "invalid statement",
+			Policy:        `{"Version":"2012-10-17","Statement":["s3:GetObject"]}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeInvalidElement},
+		},
+		{
+			Name:          "missing statement",
+			Policy:        `{"Version":"2012-10-17"}`,
+			ExpectedCodes: []string{tfiam.PolicyLintCodeInvalidElement},
+		},
+		{
+			Name:        "invalid JSON",
+			Policy:      `{"Version":`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			findings, err := tfiam.LintPolicy(testCase.Policy)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var codes []string
+			for _, finding := range findings {
+				codes = append(codes, finding.Code)
+			}
+
+			if !reflect.DeepEqual(codes, testCase.ExpectedCodes) {
+				t.Errorf("got %v, expected %v", codes, testCase.ExpectedCodes)
+			}
+		})
+	}
+}
+
+func TestLintPolicyStatementIndex(t *testing.T) {
+	findings, err := tfiam.LintPolicy(`{"Version":"2012-10-17","Statement":[
+  {"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},
+  {"Sid":"Admin","Effect":"Allow","Action":"*","Resource":"*"}
+]}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(findings))
+	}
+
+	finding := findings[0]
+
+	if finding.StatementIndex != 1 || finding.Sid != "Admin" || finding.Severity != tfiam.PolicyLintSeveritySecurityWarning {
+		t.Errorf("unexpected finding: %#v", finding)
+	}
+}
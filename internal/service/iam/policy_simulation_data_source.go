@@ -0,0 +1,206 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matched_statements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"missing_context_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions_boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+			"resource_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"service_control_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	input := &PolicySimulationInput{
+		Action:              d.Get("action").(string),
+		Context:             map[string][]string{},
+		PermissionsBoundary: d.Get("permissions_boundary").(string),
+		PrincipalARN:        d.Get("principal_arn").(string),
+		ResourceARN:         d.Get("resource_arn").(string),
+		ResourcePolicy:      d.Get("resource_policy").(string),
+	}
+
+	if v, ok := d.GetOk("identity_policies"); ok && len(v.([]interface{})) > 0 {
+		input.IdentityPolicies = expandPolicySimulationStrings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_control_policies"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceControlPolicies = expandPolicySimulationStrings(v.([]interface{}))
+	}
+
+	for _, tfMapRaw := range d.Get("context").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+		input.Context[key] = append(input.Context[key], expandPolicySimulationStrings(tfMap["values"].([]interface{}))...)
+	}
+
+	if len(input.IdentityPolicies) == 0 && input.ResourcePolicy == "" {
+		return fmt.Errorf("at least one of identity_policies or resource_policy must be specified")
+	}
+
+	result, err := SimulatePolicies(input)
+
+	if err != nil {
+		return fmt.Errorf("error simulating IAM policies: %w", err)
+	}
+
+	var tfList []interface{}
+
+	for _, m := range result.MatchedStatements {
+		tfList = append(tfList, map[string]interface{}{
+			"effect":          m.Effect,
+			"sid":             m.Sid,
+			"source_index":    m.SourceIndex,
+			"source_type":     m.SourceType,
+			"statement_index": m.StatementIndex,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join([]string{
+		input.Action,
+		input.ResourceARN,
+		input.PrincipalARN,
+		fmt.Sprintf("%v", input.Context),
+		strings.Join(input.IdentityPolicies, ""),
+		input.ResourcePolicy,
+		input.PermissionsBoundary,
+		strings.Join(input.ServiceControlPolicies, ""),
+	}, "|"))))
+	d.Set("allowed", result.Allowed())
+	d.Set("decision", result.Decision)
+
+	if err := d.Set("matched_statements", tfList); err != nil {
+		return fmt.Errorf("error setting matched_statements: %w", err)
+	}
+
+	if err := d.Set("missing_context_keys", result.MissingContextKeys); err != nil {
+		return fmt.Errorf("error setting missing_context_keys: %w", err)
+	}
+
+	return nil
+}
+
+func expandPolicySimulationStrings(tfList []interface{}) []string {
+	var vs []string
+
+	for _, v := range tfList {
+		if v, ok := v.(string); ok {
+			vs = append(vs, v)
+		}
+	}
+
+	return vs
+}
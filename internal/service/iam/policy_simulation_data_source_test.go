@@ -0,0 +1,122 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicySimulationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.0.sid", "Read"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.0.source_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "missing_context_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicySimulationDataSource_explicitDeny(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceExplicitDenyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "explicitDeny"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "matched_statements.*", map[string]string{
+						"effect":      "Deny",
+						"sid":         "DenyInsecure",
+						"source_type": "resource",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicySimulationDataSourceConfig = `
+data "aws_iam_policy_simulation" "test" {
+  action       = "s3:GetObject"
+  resource_arn = "arn:aws:s3:::example-bucket/home/alice/notes.txt"
+
+  identity_policies = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "Read"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "arn:aws:s3:::example-bucket/home/$${aws:username}/*"
+      },
+    ]
+  })]
+
+  context {
+    key    = "aws:username"
+    values = ["alice"]
+  }
+}
+`
+
+const testAccPolicySimulationDataSourceExplicitDenyConfig = `
+data "aws_iam_policy_simulation" "test" {
+  action        = "s3:GetObject"
+  resource_arn  = "arn:aws:s3:::example-bucket/file.txt"
+  principal_arn = "arn:aws:iam::123456789012:role/example"
+
+  identity_policies = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = "s3:*"
+        Resource = "*"
+      },
+    ]
+  })]
+
+  resource_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid       = "DenyInsecure"
+        Effect    = "Deny"
+        Principal = "*"
+        Action    = "s3:*"
+        Resource  = "*"
+        Condition = {
+          Bool = {
+            "aws:SecureTransport" = "false"
+          }
+        }
+      },
+    ]
+  })
+
+  context {
+    key    = "aws:SecureTransport"
+    values = ["false"]
+  }
+}
+`
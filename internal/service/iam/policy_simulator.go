@@ -0,0 +1,645 @@
+package iam
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Policy simulation decisions. These match the EvalDecision values returned by
+// the IAM policy simulator API.
+const (
+	PolicySimulationDecisionAllowed      = "allowed"
+	PolicySimulationDecisionExplicitDeny = "explicitDeny"
+	PolicySimulationDecisionImplicitDeny = "implicitDeny"
+)
+
+// Policy simulation policy source types.
+const (
+	PolicySourceTypeIdentity           = "identity"
+	PolicySourceTypePermissionBoundary = "permissions_boundary"
+	PolicySourceTypeResource           = "resource"
+	PolicySourceTypeSCP                = "service_control_policy"
+)
+
+// PolicySimulationInput describes an offline policy simulation.
+// Each policy is an IAM policy JSON document.
+type PolicySimulationInput struct {
+	IdentityPolicies       []string
+	ResourcePolicy         string
+	PermissionsBoundary    string
+	ServiceControlPolicies []string
+
+	PrincipalARN string
+	Action       string
+	ResourceARN  string
+	// Context maps condition context keys, e.g. "aws:SourceIp", to their values.
+	// Keys are case insensitive.
+	Context map[string][]string
+}
+
+// PolicySimulationResult is the result of an offline policy simulation.
+type PolicySimulationResult struct {
+	Decision           string
+	MatchedStatements  []*PolicySimulationMatchedStatement
+	MissingContextKeys []string
+}
+
+// PolicySimulationMatchedStatement identifies a statement that applies to the simulated request.
+type PolicySimulationMatchedStatement struct {
+	SourceType     string
+	SourceIndex    int
+	StatementIndex int
+	Sid            string
+	Effect         string
+}
+
+func (r *PolicySimulationResult) Allowed() bool {
+	return r.Decision == PolicySimulationDecisionAllowed
+}
+
+type simulationPolicy struct {
+	sourceType  string
+	sourceIndex int
+	doc         *CanonicalPolicyDoc
+}
+
+// policyEvaluation is the result of evaluating a set of policies against a request.
+type policyEvaluation struct {
+	allow bool
+	deny  bool
+	// allowViaAccount is set for resource policies where the only matching
+	// Allow statements name the principal's account rather than the principal.
+	allowViaAccount bool
+}
+
+type policySimulator struct {
+	input   *PolicySimulationInput
+	context map[string][]string
+	result  *PolicySimulationResult
+	missing map[string]bool
+}
+
+// SimulatePolicies evaluates whether the request described by the input is
+// allowed by the specified policies, following the documented IAM policy
+// evaluation logic:
+//   - an explicit Deny in any policy denies the request
+//   - each service control policy must allow the request
+//   - within an account, a resource policy that allows the principal ARN allows the request
+//   - otherwise an identity policy must allow the request and, if specified,
+//     the permissions boundary must also allow it
+//   - across accounts, both an identity policy and the resource policy must allow the request
+func SimulatePolicies(input *PolicySimulationInput) (*PolicySimulationResult, error) {
+	s := &policySimulator{
+		input:   input,
+		context: map[string][]string{},
+		result:  &PolicySimulationResult{},
+		missing: map[string]bool{},
+	}
+
+	for k, v := range input.Context {
+		s.context[strings.ToLower(k)] = v
+	}
+
+	var principalAccount string
+	if input.PrincipalARN != "" {
+		if v, err := arn.Parse(input.PrincipalARN); err == nil {
+			principalAccount = v.AccountID
+		}
+
+		if _, ok := s.context["aws:principalarn"]; !ok {
+			s.context["aws:principalarn"] = []string{input.PrincipalARN}
+		}
+		if _, ok := s.context["aws:principalaccount"]; !ok && principalAccount != "" {
+			s.context["aws:principalaccount"] = []string{principalAccount}
+		}
+	}
+
+	parse := func(sourceType string, policies []string) ([]*simulationPolicy, error) {
+		var out []*simulationPolicy
+
+		for i, policy := range policies {
+			if policy == "" {
+				continue
+			}
+
+			doc, err := parseCanonicalPolicy(policy)
+			if err != nil {
+				return nil, fmt.Errorf("%s policy %d: %w", sourceType, i, err)
+			}
+
+			out = append(out, &simulationPolicy{sourceType: sourceType, sourceIndex: i, doc: doc})
+		}
+
+		return out, nil
+	}
+
+	identityPolicies, err := parse(PolicySourceTypeIdentity, input.IdentityPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	resourcePolicies, err := parse(PolicySourceTypeResource, []string{input.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	boundaryPolicies, err := parse(PolicySourceTypePermissionBoundary, []string{input.PermissionsBoundary})
+	if err != nil {
+		return nil, err
+	}
+
+	scps, err := parse(PolicySourceTypeSCP, input.ServiceControlPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := s.evaluate(identityPolicies, false)
+	resource := s.evaluate(resourcePolicies, true)
+	boundary := s.evaluate(boundaryPolicies, false)
+
+	scpDeny, scpAllow := false, true
+	for _, scp := range scps {
+		e := s.evaluate([]*simulationPolicy{scp}, false)
+		scpDeny = scpDeny || e.deny
+		scpAllow = scpAllow && e.allow
+	}
+
+	sort.Strings(s.result.MissingContextKeys)
+
+	switch {
+	case identity.deny || resource.deny || boundary.deny || scpDeny:
+		s.result.Decision = PolicySimulationDecisionExplicitDeny
+		return s.result, nil
+	case !scpAllow:
+		s.result.Decision = PolicySimulationDecisionImplicitDeny
+		return s.result, nil
+	}
+
+	identityAllow := identity.allow && (len(boundaryPolicies) == 0 || boundary.allow)
+
+	var resourceAccount string
+	if v, err := arn.Parse(input.ResourceARN); err == nil {
+		resourceAccount = v.AccountID
+	}
+
+	crossAccount := principalAccount != "" && resourceAccount != "" && principalAccount != resourceAccount
+
+	var allowed bool
+	if crossAccount {
+		allowed = identityAllow && (resource.allow || resource.allowViaAccount)
+	} else {
+		allowed = identityAllow || resource.allow
+	}
+
+	if allowed {
+		s.result.Decision = PolicySimulationDecisionAllowed
+	} else {
+		s.result.Decision = PolicySimulationDecisionImplicitDeny
+	}
+
+	return s.result, nil
+}
+
+func (s *policySimulator) evaluate(policies []*simulationPolicy, matchPrincipal bool) policyEvaluation {
+	var e policyEvaluation
+
+	for _, p := range policies {
+		for i, statement := range p.doc.Statements {
+			if !s.statementApplies(statement, p.doc.Version) {
+				continue
+			}
+
+			viaAccount := false
+			if matchPrincipal {
+				var ok bool
+				if ok, viaAccount = s.principalMatches(statement); !ok {
+					continue
+				}
+			}
+
+			s.result.MatchedStatements = append(s.result.MatchedStatements, &PolicySimulationMatchedStatement{
+				SourceType:     p.sourceType,
+				SourceIndex:    p.sourceIndex,
+				StatementIndex: i,
+				Sid:            statement.Sid,
+				Effect:         statement.Effect,
+			})
+
+			switch statement.Effect {
+			case "Deny":
+				e.deny = true
+			case "Allow":
+				if viaAccount {
+					e.allowViaAccount = true
+				} else {
+					e.allow = true
+				}
+			}
+		}
+	}
+
+	return e
+}
+
+func (s *policySimulator) statementApplies(statement *CanonicalPolicyStatement, version string) bool {
+	action := strings.ToLower(s.input.Action)
+
+	if len(statement.Actions) > 0 && !matchesAny(statement.Actions, action, false) {
+		return false
+	}
+	if len(statement.NotActions) > 0 && matchesAny(statement.NotActions, action, false) {
+		return false
+	}
+
+	resources := s.substitutePolicyVariables(statement.Resources, version)
+	notResources := s.substitutePolicyVariables(statement.NotResources, version)
+
+	if len(statement.Resources) > 0 && !matchesAny(resources, s.input.ResourceARN, true) {
+		return false
+	}
+	if len(statement.NotResources) > 0 && matchesAny(notResources, s.input.ResourceARN, true) {
+		return false
+	}
+
+	return s.conditionsMatch(statement.Conditions, version)
+}
+
+// principalMatches returns whether or not the resource policy statement applies to
+// the simulated principal, and whether the match is only via the principal's account.
+func (s *policySimulator) principalMatches(statement *CanonicalPolicyStatement) (bool, bool) {
+	principal := s.input.PrincipalARN
+
+	match := func(principals map[string][]string) (bool, bool) {
+		viaAccount := false
+
+		for _, v := range principals["AWS"] {
+			if v == "*" || globMatch(v, principal, true) {
+				return true, false
+			}
+
+			if account := principalAccountID(v); account != "" {
+				if p, err := arn.Parse(principal); err == nil && p.AccountID == account {
+					viaAccount = true
+				}
+			}
+		}
+
+		return viaAccount, viaAccount
+	}
+
+	if len(statement.NotPrincipals) > 0 {
+		ok, _ := match(statement.NotPrincipals)
+		return !ok, false
+	}
+
+	return match(statement.Principals)
+}
+
+// principalAccountID returns the account ID for account principals, which are
+// either a 12 digit account ID or the account's root user ARN.
+func principalAccountID(v string) string {
+	if accountIDOnlyRegexp.MatchString(v) {
+		return v
+	}
+
+	if p, err := arn.Parse(v); err == nil && p.Service == "iam" && p.Resource == "root" {
+		return p.AccountID
+	}
+
+	return ""
+}
+
+func (s *policySimulator) conditionsMatch(conditions map[string]map[string][]string, version string) bool {
+	for operator, keys := range conditions {
+		op, ok := parseConditionOperator(operator)
+		if !ok {
+			// Unknown operators never match, as in IAM.
+			return false
+		}
+
+		for key, values := range keys {
+			if !s.conditionMatches(op, key, s.substitutePolicyVariables(values, version)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (s *policySimulator) conditionMatches(op conditionOperator, key string, values []string) bool {
+	contextValues, present := s.context[key]
+
+	if op.Name == "Null" {
+		// "true" matches if the key is absent.
+		for _, v := range values {
+			if strings.EqualFold(v, "true") != present {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !present {
+		s.addMissingContextKey(key)
+
+		switch {
+		case op.IfExists, op.SetQualifier == "ForAllValues":
+			return true
+		case op.SetQualifier == "ForAnyValue":
+			return false
+		default:
+			return isNegatedConditionOperator(op.Name)
+		}
+	}
+
+	matchValue := func(contextValue string) bool {
+		for _, v := range values {
+			if conditionValueMatches(op.Name, v, contextValue) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if isNegatedConditionOperator(op.Name) {
+		// Negated operators are evaluated as the negation of the positive operator.
+		positive := positiveConditionOperator(op.Name)
+		matchValue = func(contextValue string) bool {
+			for _, v := range values {
+				if conditionValueMatches(positive, v, contextValue) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	switch op.SetQualifier {
+	case "ForAllValues":
+		for _, v := range contextValues {
+			if !matchValue(v) {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, v := range contextValues {
+			if matchValue(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (s *policySimulator) addMissingContextKey(key string) {
+	if !s.missing[key] {
+		s.missing[key] = true
+		s.result.MissingContextKeys = append(s.result.MissingContextKeys, key)
+	}
+}
+
+// substitutePolicyVariables replaces policy variables such as ${aws:username}
+// with values from the request context. Policy variables are only supported
+// in version 2012-10-17 policies. Values referencing absent keys are dropped.
+func (s *policySimulator) substitutePolicyVariables(values []string, version string) []string {
+	if version != "2012-10-17" {
+		return values
+	}
+
+	var out []string
+
+	for _, v := range values {
+		if !strings.Contains(v, "${") {
+			out = append(out, v)
+			continue
+		}
+
+		ok := true
+		v = policyVariableRegexp.ReplaceAllStringFunc(v, func(m string) string {
+			name := m[2 : len(m)-1]
+
+			switch name {
+			case "*", "?", "$":
+				return name
+			}
+
+			// Default values, e.g. ${aws:username, 'anonymous'}.
+			var defaultValue *string
+			if i := strings.Index(name, ","); i >= 0 {
+				d := strings.Trim(strings.TrimSpace(name[i+1:]), "'")
+				defaultValue = &d
+				name = strings.TrimSpace(name[:i])
+			}
+
+			if cv, present := s.context[strings.ToLower(name)]; present && len(cv) > 0 {
+				return cv[0]
+			}
+
+			if defaultValue != nil {
+				return *defaultValue
+			}
+
+			s.addMissingContextKey(strings.ToLower(name))
+			ok = false
+
+			return m
+		})
+
+		if ok {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+var negatedConditionOperators = map[string]string{
+	"ArnNotEquals":              "ArnEquals",
+	"ArnNotLike":                "ArnLike",
+	"DateNotEquals":             "DateEquals",
+	"NotIpAddress":              "IpAddress",
+	"NumericNotEquals":          "NumericEquals",
+	"StringNotEquals":           "StringEquals",
+	"StringNotEqualsIgnoreCase": "StringEqualsIgnoreCase",
+	"StringNotLike":             "StringLike",
+}
+
+func isNegatedConditionOperator(name string) bool {
+	_, ok := negatedConditionOperators[name]
+	return ok
+}
+
+func positiveConditionOperator(name string) string {
+	if v, ok := negatedConditionOperators[name]; ok {
+		return v
+	}
+	return name
+}
+
+// conditionValueMatches evaluates a positive condition operator for a single
+// policy value and context value.
+func conditionValueMatches(operator, policyValue, contextValue string) bool {
+	switch operator {
+	case "StringEquals":
+		return policyValue == contextValue
+	case "StringEqualsIgnoreCase":
+		return strings.EqualFold(policyValue, contextValue)
+	case "StringLike":
+		return globMatch(policyValue, contextValue, true)
+	case "ArnEquals", "ArnLike":
+		return arnMatch(policyValue, contextValue)
+	case "Bool":
+		return strings.EqualFold(policyValue, contextValue)
+	case "BinaryEquals":
+		return policyValue == contextValue
+	case "IpAddress":
+		return ipAddressMatch(policyValue, contextValue)
+	case "NumericEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals":
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false
+		}
+		c, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false
+		}
+		return compareOrdered(operator[len("Numeric"):], c < p, c == p)
+	case "DateEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals":
+		p, ok := parseConditionDate(policyValue)
+		if !ok {
+			return false
+		}
+		c, ok := parseConditionDate(contextValue)
+		if !ok {
+			return false
+		}
+		return compareOrdered(operator[len("Date"):], c.Before(p), c.Equal(p))
+	}
+
+	return false
+}
+
+func compareOrdered(comparison string, less, equal bool) bool {
+	switch comparison {
+	case "Equals":
+		return equal
+	case "LessThan":
+		return less
+	case "LessThanEquals":
+		return less || equal
+	case "GreaterThan":
+		return !less && !equal
+	case "GreaterThanEquals":
+		return !less
+	}
+	return false
+}
+
+// parseConditionDate parses an ISO 8601 date or a Unix epoch time.
+func parseConditionDate(v string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(n, 0), true
+	}
+
+	return time.Time{}, false
+}
+
+func ipAddressMatch(policyValue, contextValue string) bool {
+	ip := net.ParseIP(contextValue)
+	if ip == nil {
+		return false
+	}
+
+	if !strings.Contains(policyValue, "/") {
+		p := net.ParseIP(policyValue)
+		return p != nil && p.Equal(ip)
+	}
+
+	_, cidr, err := net.ParseCIDR(policyValue)
+	if err != nil {
+		return false
+	}
+
+	return cidr.Contains(ip)
+}
+
+// arnMatch matches an ARN against a pattern segment by segment, so that
+// wildcards cannot match across the partition, service, region or account segments.
+func arnMatch(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	ps := strings.SplitN(pattern, ":", 6)
+	vs := strings.SplitN(value, ":", 6)
+
+	if len(ps) != 6 || len(vs) != 6 {
+		return false
+	}
+
+	for i := range ps {
+		if !globMatch(ps[i], vs[i], true) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesAny(patterns []string, value string, caseSensitive bool) bool {
+	for _, p := range patterns {
+		if globMatch(p, value, caseSensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// globMatch matches a value against a pattern in which "*" matches any sequence
+// of characters and "?" matches any single character.
+func globMatch(pattern, value string, caseSensitive bool) bool {
+	if !caseSensitive {
+		pattern, value = strings.ToLower(pattern), strings.ToLower(value)
+	}
+
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	star, match := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, match = pi, vi
+			pi++
+		case star >= 0:
+			pi = star + 1
+			match++
+			vi = match
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
@@ -0,0 +1,411 @@
+package iam_test
+
+import (
+	"reflect"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestSimulatePolicies(t *testing.T) {
+	const (
+		principalARN = "arn:aws:iam::123456789012:role/example"
+		objectARN    = "arn:aws:s3:::example-bucket/data/file.txt"
+	)
+
+	testCases := []struct {
+		Name             string
+		Input            *tfiam.PolicySimulationInput
+		ExpectedDecision string
+	}{
+		{
+			Name: "identity allow",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "action case insensitive",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:Get*","Resource":"*"}]}`},
+				Action:           "s3:getobject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "resource case sensitive",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Example-Bucket/*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "single character wildcard",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/data/fil?.txt"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "implicit deny",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "explicit deny overrides allow",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/data/*"}]}`,
+				},
+				Action:      "s3:GetObject",
+				ResourceARN: objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionExplicitDeny,
+		},
+		{
+			Name: "not action",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "not resource",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::example-bucket/*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "permissions boundary does not allow",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies:    []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				PermissionsBoundary: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+				Action:              "s3:GetObject",
+				ResourceARN:         objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "permissions boundary allows",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies:    []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				PermissionsBoundary: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}]}`,
+				Action:              "s3:GetObject",
+				ResourceARN:         objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "service control policy does not allow",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				ServiceControlPolicies: []string{
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`,
+				},
+				Action:      "s3:GetObject",
+				ResourceARN: objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "service control policy deny",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies:       []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				ServiceControlPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`},
+				Action:                 "s3:GetObject",
+				ResourceARN:            objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionExplicitDeny,
+		},
+		{
+			Name: "same account resource policy allows principal",
+			Input: &tfiam.PolicySimulationInput{
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+				PrincipalARN:   principalARN,
+				Action:         "sqs:SendMessage",
+				ResourceARN:    "arn:aws:sqs:us-west-2:123456789012:queue", //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "same account resource policy allows account",
+			Input: &tfiam.PolicySimulationInput{
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+				PrincipalARN:   principalARN,
+				Action:         "sqs:SendMessage",
+				ResourceARN:    "arn:aws:sqs:us-west-2:123456789012:queue", //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "same account resource policy bypasses permissions boundary",
+			Input: &tfiam.PolicySimulationInput{
+				ResourcePolicy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+				PermissionsBoundary: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+				PrincipalARN:        principalARN,
+				Action:              "sqs:SendMessage",
+				ResourceARN:         "arn:aws:sqs:us-west-2:123456789012:queue", //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "cross account requires identity allow",
+			Input: &tfiam.PolicySimulationInput{
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+				PrincipalARN:   principalARN,
+				Action:         "sqs:SendMessage",
+				ResourceARN:    "arn:aws:sqs:us-west-2:210987654321:queue", //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "cross account identity and resource allow",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`},
+				ResourcePolicy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+				PrincipalARN:     principalARN,
+				Action:           "sqs:SendMessage",
+				ResourceARN:      "arn:aws:sqs:us-west-2:210987654321:queue", //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "resource policy other principal",
+			Input: &tfiam.PolicySimulationInput{
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/other"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+				PrincipalARN:   principalARN,
+				Action:         "sqs:SendMessage",
+				ResourceARN:    "arn:aws:sqs:us-west-2:123456789012:queue", //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "StringLike condition",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":{"s3:prefix":["home/*"]}}}]}`},
+				Action:           "s3:ListBucket",
+				ResourceARN:      "arn:aws:s3:::example-bucket",
+				Context:          map[string][]string{"s3:prefix": {"home/user"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "StringLike condition missing key",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":{"s3:prefix":["home/*"]}}}]}`},
+				Action:           "s3:ListBucket",
+				ResourceARN:      "arn:aws:s3:::example-bucket",
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "IfExists condition missing key",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}]}`},
+				Action:           "ec2:RunInstances",
+				ResourceARN:      "*",
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "ArnLike condition",
+			Input: &tfiam.PolicySimulationInput{
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:123456789012:*"}}},{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:123456789012:*"}}}]}`,
+				Action:         "sqs:SendMessage",
+				ResourceARN:    "arn:aws:sqs:us-west-2:123456789012:queue",                                         //lintignore:AWSAT003
+				Context:        map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}}, //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "ArnLike condition does not match across segments",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*"}}}]}`},
+				Action:           "sqs:SendMessage",
+				ResourceARN:      "*",
+				Context:          map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}}, //lintignore:AWSAT003
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "IpAddress condition",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["192.0.2.0/24","203.0.113.10"]}}}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+				Context:          map[string][]string{"aws:sourceip": {"192.0.2.55"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "NotIpAddress deny",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"192.0.2.0/24"}}}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+				Context:          map[string][]string{"aws:SourceIp": {"198.51.100.1"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionExplicitDeny,
+		},
+		{
+			Name: "Bool condition",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+				Context:          map[string][]string{"aws:SecureTransport": {"true"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "Numeric and Date conditions",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":"10"},"DateGreaterThan":{"aws:CurrentTime":"2020-01-01T00:00:00Z"}}}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+				Context:          map[string][]string{"s3:max-keys": {"10"}, "aws:CurrentTime": {"2021-06-01T12:00:00Z"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "ForAllValues condition",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["Name","Environment"]}}}]}`},
+				Action:           "ec2:CreateTags",
+				ResourceARN:      "*",
+				Context:          map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "ForAnyValue condition",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":["Name","Environment"]}}}]}`},
+				Action:           "ec2:CreateTags",
+				ResourceARN:      "*",
+				Context:          map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "Null condition",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Null":{"aws:TokenIssueTime":"true"}}}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "policy variable",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/home/${aws:username}/*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      "arn:aws:s3:::example-bucket/home/alice/notes.txt",
+				Context:          map[string][]string{"aws:username": {"alice"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionAllowed,
+		},
+		{
+			Name: "policy variable ignored in version 2008-10-17",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/home/${aws:username}/*"}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      "arn:aws:s3:::example-bucket/home/alice/notes.txt",
+				Context:          map[string][]string{"aws:username": {"alice"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+		{
+			Name: "unknown condition operator",
+			Input: &tfiam.PolicySimulationInput{
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:SourceVpc":"vpc-1"}}}]}`},
+				Action:           "s3:GetObject",
+				ResourceARN:      objectARN,
+				Context:          map[string][]string{"aws:SourceVpc": {"vpc-1"}},
+			},
+			ExpectedDecision: tfiam.PolicySimulationDecisionImplicitDeny,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			result, err := tfiam.SimulatePolicies(testCase.Input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if result.Decision != testCase.ExpectedDecision {
+				t.Errorf("got %s, expected %s", result.Decision, testCase.ExpectedDecision)
+			}
+		})
+	}
+}
+
+func TestSimulatePoliciesMatchedStatements(t *testing.T) {
+	result, err := tfiam.SimulatePolicies(&tfiam.PolicySimulationInput{
+		IdentityPolicies: []string{
+			`{"Version":"2012-10-17","Statement":[{"Sid":"Other","Effect":"Allow","Action":"ec2:*","Resource":"*"},{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+		},
+		Action:      "s3:GetObject",
+		ResourceARN: "arn:aws:s3:::example-bucket/file.txt",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !result.Allowed() {
+		t.Errorf("expected request to be allowed, got %s", result.Decision)
+	}
+
+	expected := []*tfiam.PolicySimulationMatchedStatement{
+		{
+			SourceType:     tfiam.PolicySourceTypeIdentity,
+			SourceIndex:    1,
+			StatementIndex: 0,
+			Effect:         "Allow",
+		},
+	}
+
+	if !reflect.DeepEqual(result.MatchedStatements, expected) {
+		t.Errorf("got matched statements %#v, expected %#v", result.MatchedStatements, expected)
+	}
+
+	if expected := []string{"aws:sourcevpc"}; !reflect.DeepEqual(result.MissingContextKeys, expected) {
+		t.Errorf("got missing context keys %v, expected %v", result.MissingContextKeys, expected)
+	}
+}
+
+func TestSimulatePoliciesInvalidPolicy(t *testing.T) {
+	_, err := tfiam.SimulatePolicies(&tfiam.PolicySimulationInput{
+		IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":`},
+		Action:           "s3:GetObject",
+		ResourceARN:      "*",
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
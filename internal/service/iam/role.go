@@ -93,7 +93,7 @@ func ResourceRole() *schema.Resource {
 			"assume_role_policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentPolicyDiffs,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 
@@ -136,7 +136,7 @@ func ResourceRole() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							ValidateFunc:     verify.ValidIAMPolicyJSON,
-							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+							DiffSuppressFunc: suppressEquivalentPolicyDiffs,
 						},
 					},
 				},
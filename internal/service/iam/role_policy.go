@@ -33,7 +33,11 @@ func ResourceRolePolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentPolicyDiffs,
+			},
+			"policy_diff": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -56,6 +60,8 @@ func ResourceRolePolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: setPolicyDiff,
 	}
 }
 
@@ -141,6 +147,10 @@ func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("policy", policy); err != nil {
 		return err
 	}
+	// The policy difference is only shown in plans.
+	if err := d.Set("policy_diff", ""); err != nil {
+		return err
+	}
 	if err := d.Set("name", name); err != nil {
 		return err
 	}
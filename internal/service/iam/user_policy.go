@@ -33,7 +33,11 @@ func ResourceUserPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentPolicyDiffs,
+			},
+			"policy_diff": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -54,6 +58,8 @@ func ResourceUserPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: setPolicyDiff,
 	}
 }
 
@@ -145,6 +151,10 @@ func resourceUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("policy", policy); err != nil {
 		return err
 	}
+	// The policy difference is only shown in plans.
+	if err := d.Set("policy_diff", ""); err != nil {
+		return err
+	}
 	if err := d.Set("name", name); err != nil {
 		return err
 	}
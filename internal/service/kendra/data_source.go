@@ -0,0 +1,894 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataSourceCreate,
+		Read:   resourceDataSourceRead,
+		Update: resourceDataSourceUpdate,
+		Delete: resourceDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.s3_configuration", "configuration.0.web_crawler_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_list_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key_path": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"documents_metadata_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"exclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"inclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"inclusion_prefixes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+								},
+							},
+						},
+						"web_crawler_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.s3_configuration", "configuration.0.web_crawler_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"basic_authentication": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MaxItems: 10,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"credentials": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"host": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 253),
+															},
+															"port": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IsPortNumber,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"crawl_depth": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(0, 10),
+									},
+									"max_content_size_per_page_in_mega_bytes": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Default:      50,
+										ValidateFunc: validation.FloatBetween(0.000001, 50),
+									},
+									"max_links_per_page": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      100,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"max_urls_per_minute_crawl_rate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      300,
+										ValidateFunc: validation.IntBetween(1, 300),
+									},
+									"proxy_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"credentials": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"host": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 253),
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"url_exclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"url_inclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"urls": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"seed_url_configuration": {
+													Type:         schema.TypeList,
+													Optional:     true,
+													MaxItems:     1,
+													ExactlyOneOf: []string{"configuration.0.web_crawler_configuration.0.urls.0.seed_url_configuration", "configuration.0.web_crawler_configuration.0.urls.0.site_maps_configuration"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"seed_urls": {
+																Type:     schema.TypeSet,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 100,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: validation.StringLenBetween(1, 2048),
+																},
+															},
+															"web_crawler_mode": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Default:      kendra.WebCrawlerModeHostOnly,
+																ValidateFunc: validation.StringInSlice(kendra.WebCrawlerMode_Values(), false),
+															},
+														},
+													},
+												},
+												"site_maps_configuration": {
+													Type:         schema.TypeList,
+													Optional:     true,
+													MaxItems:     1,
+													ExactlyOneOf: []string{"configuration.0.web_crawler_configuration.0.urls.0.seed_url_configuration", "configuration.0.web_crawler_configuration.0.urls.0.site_maps_configuration"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"site_maps": {
+																Type:     schema.TypeSet,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 3,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: validation.StringLenBetween(1, 2048),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 10),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{kendra.DataSourceTypeCustom, kendra.DataSourceTypeS3, kendra.DataSourceTypeWebcrawler}, false),
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	indexID := d.Get("index_id").(string)
+	name := d.Get("name").(string)
+	input := &kendra.CreateDataSourceInput{
+		IndexId: aws.String(indexID),
+		Name:    aws.String(name),
+		Type:    aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("language_code"); ok {
+		input.LanguageCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Data Source: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateDataSource(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+				tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Data Source (%s): %w", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateDataSourceOutput).Id)
+
+	d.SetId(CreateResourceID(id, indexID))
+
+	if _, err := waitDataSourceCreated(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra Data Source (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceDataSourceRead(d, meta)
+}
+
+func resourceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	dataSource, err := FindDataSourceByID(conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Data Source (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/data-source/%s", indexID, id),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("configuration", flattenDataSourceConfiguration(dataSource.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("created_at", aws.TimeValue(dataSource.CreatedAt).Format(time.RFC3339))
+	d.Set("data_source_id", dataSource.Id)
+	d.Set("description", dataSource.Description)
+	d.Set("error_message", dataSource.ErrorMessage)
+	d.Set("index_id", dataSource.IndexId)
+	d.Set("language_code", dataSource.LanguageCode)
+	d.Set("name", dataSource.Name)
+	d.Set("role_arn", dataSource.RoleArn)
+	d.Set("schedule", dataSource.Schedule)
+	d.Set("status", dataSource.Status)
+	d.Set("type", dataSource.Type)
+	d.Set("updated_at", aws.TimeValue(dataSource.UpdatedAt).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra Data Source (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexID, err := ParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &kendra.UpdateDataSourceInput{
+			Id:      aws.String(id),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("configuration") {
+			if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Configuration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("language_code") {
+			input.LanguageCode = aws.String(d.Get("language_code").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("schedule") {
+			input.Schedule = aws.String(d.Get("schedule").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Data Source: %s", input)
+		_, err = tfresource.RetryWhen(
+			tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateDataSource(input)
+			},
+			func(err error) (bool, error) {
+				if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+					tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kendra Data Source (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitDataSourceUpdated(conn, id, indexID); err != nil {
+			return fmt.Errorf("error waiting for Kendra Data Source (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra Data Source (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDataSourceRead(d, meta)
+}
+
+func resourceDataSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Data Source: %s", d.Id())
+	_, err = conn.DeleteDataSource(&kendra.DeleteDataSourceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Data Source (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDataSourceDeleted(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra Data Source (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataSourceConfiguration(tfMap map[string]interface{}) *kendra.DataSourceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.DataSourceConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandS3DataSourceConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["web_crawler_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WebCrawlerConfiguration = expandWebCrawlerConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3DataSourceConfiguration(tfMap map[string]interface{}) *kendra.S3DataSourceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.S3DataSourceConfiguration{}
+
+	if v, ok := tfMap["access_control_list_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AccessControlListConfiguration = &kendra.AccessControlListConfiguration{}
+
+		if v, ok := tfMap["key_path"].(string); ok && v != "" {
+			apiObject.AccessControlListConfiguration.KeyPath = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["documents_metadata_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DocumentsMetadataConfiguration = &kendra.DocumentsMetadataConfiguration{}
+
+		if v, ok := tfMap["s3_prefix"].(string); ok && v != "" {
+			apiObject.DocumentsMetadataConfiguration.S3Prefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["exclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["inclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["inclusion_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InclusionPrefixes = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandWebCrawlerConfiguration(tfMap map[string]interface{}) *kendra.WebCrawlerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.WebCrawlerConfiguration{
+		CrawlDepth:                       aws.Int64(int64(tfMap["crawl_depth"].(int))),
+		MaxContentSizePerPageInMegaBytes: aws.Float64(tfMap["max_content_size_per_page_in_mega_bytes"].(float64)),
+		MaxLinksPerPage:                  aws.Int64(int64(tfMap["max_links_per_page"].(int))),
+		MaxUrlsPerMinuteCrawlRate:        aws.Int64(int64(tfMap["max_urls_per_minute_crawl_rate"].(int))),
+	}
+
+	if v, ok := tfMap["authentication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AuthenticationConfiguration = &kendra.AuthenticationConfiguration{}
+
+		if v, ok := tfMap["basic_authentication"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AuthenticationConfiguration.BasicAuthentication = expandBasicAuthentication(v.List())
+		}
+	}
+
+	if v, ok := tfMap["proxy_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProxyConfiguration = expandProxyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["url_exclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UrlExclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["url_inclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UrlInclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["urls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Urls = expandURLs(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandBasicAuthentication(tfList []interface{}) []*kendra.BasicAuthenticationConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*kendra.BasicAuthenticationConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &kendra.BasicAuthenticationConfiguration{
+			Credentials: aws.String(tfMap["credentials"].(string)),
+			Host:        aws.String(tfMap["host"].(string)),
+			Port:        aws.Int64(int64(tfMap["port"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandProxyConfiguration(tfMap map[string]interface{}) *kendra.ProxyConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.ProxyConfiguration{
+		Host: aws.String(tfMap["host"].(string)),
+		Port: aws.Int64(int64(tfMap["port"].(int))),
+	}
+
+	if v, ok := tfMap["credentials"].(string); ok && v != "" {
+		apiObject.Credentials = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandURLs(tfMap map[string]interface{}) *kendra.Urls {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.Urls{}
+
+	if v, ok := tfMap["seed_url_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SeedUrlConfiguration = &kendra.SeedUrlConfiguration{
+			SeedUrls: flex.ExpandStringSet(tfMap["seed_urls"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["web_crawler_mode"].(string); ok && v != "" {
+			apiObject.SeedUrlConfiguration.WebCrawlerMode = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["site_maps_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SiteMapsConfiguration = &kendra.SiteMapsConfiguration{
+			SiteMaps: flex.ExpandStringSet(tfMap["site_maps"].(*schema.Set)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDataSourceConfiguration(apiObject *kendra.DataSourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = flattenS3DataSourceConfiguration(v)
+	}
+
+	if v := apiObject.WebCrawlerConfiguration; v != nil {
+		tfMap["web_crawler_configuration"] = flattenWebCrawlerConfiguration(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3DataSourceConfiguration(apiObject *kendra.S3DataSourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name":        aws.StringValue(apiObject.BucketName),
+		"exclusion_patterns": aws.StringValueSlice(apiObject.ExclusionPatterns),
+		"inclusion_patterns": aws.StringValueSlice(apiObject.InclusionPatterns),
+		"inclusion_prefixes": aws.StringValueSlice(apiObject.InclusionPrefixes),
+	}
+
+	if v := apiObject.AccessControlListConfiguration; v != nil {
+		tfMap["access_control_list_configuration"] = []interface{}{
+			map[string]interface{}{
+				"key_path": aws.StringValue(v.KeyPath),
+			},
+		}
+	}
+
+	if v := apiObject.DocumentsMetadataConfiguration; v != nil {
+		tfMap["documents_metadata_configuration"] = []interface{}{
+			map[string]interface{}{
+				"s3_prefix": aws.StringValue(v.S3Prefix),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWebCrawlerConfiguration(apiObject *kendra.WebCrawlerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"crawl_depth": aws.Int64Value(apiObject.CrawlDepth),
+		"max_content_size_per_page_in_mega_bytes": aws.Float64Value(apiObject.MaxContentSizePerPageInMegaBytes),
+		"max_links_per_page":                      aws.Int64Value(apiObject.MaxLinksPerPage),
+		"max_urls_per_minute_crawl_rate":          aws.Int64Value(apiObject.MaxUrlsPerMinuteCrawlRate),
+		"url_exclusion_patterns":                  aws.StringValueSlice(apiObject.UrlExclusionPatterns),
+		"url_inclusion_patterns":                  aws.StringValueSlice(apiObject.UrlInclusionPatterns),
+	}
+
+	if v := apiObject.AuthenticationConfiguration; v != nil {
+		tfMap["authentication_configuration"] = []interface{}{
+			map[string]interface{}{
+				"basic_authentication": flattenBasicAuthentication(v.BasicAuthentication),
+			},
+		}
+	}
+
+	if v := apiObject.ProxyConfiguration; v != nil {
+		tfMap["proxy_configuration"] = []interface{}{
+			map[string]interface{}{
+				"credentials": aws.StringValue(v.Credentials),
+				"host":        aws.StringValue(v.Host),
+				"port":        aws.Int64Value(v.Port),
+			},
+		}
+	}
+
+	if v := apiObject.Urls; v != nil {
+		tfMap["urls"] = flattenURLs(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenBasicAuthentication(apiObjects []*kendra.BasicAuthenticationConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"credentials": aws.StringValue(apiObject.Credentials),
+			"host":        aws.StringValue(apiObject.Host),
+			"port":        aws.Int64Value(apiObject.Port),
+		})
+	}
+
+	return tfList
+}
+
+func flattenURLs(apiObject *kendra.Urls) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SeedUrlConfiguration; v != nil {
+		tfMap["seed_url_configuration"] = []interface{}{
+			map[string]interface{}{
+				"seed_urls":        aws.StringValueSlice(v.SeedUrls),
+				"web_crawler_mode": aws.StringValue(v.WebCrawlerMode),
+			},
+		}
+	}
+
+	if v := apiObject.SiteMapsConfiguration; v != nil {
+		tfMap["site_maps_configuration"] = []interface{}{
+			map[string]interface{}{
+				"site_maps": aws.StringValueSlice(v.SiteMaps),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
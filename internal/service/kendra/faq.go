@@ -0,0 +1,327 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFaq() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFaqCreate,
+		Read:   resourceFaqRead,
+		Update: resourceFaqUpdate,
+		Delete: resourceFaqDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"faq_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kendra.FaqFileFormat_Values(), false),
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 10),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_path": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFaqCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	indexID := d.Get("index_id").(string)
+	name := d.Get("name").(string)
+	input := &kendra.CreateFaqInput{
+		IndexId: aws.String(indexID),
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("file_format"); ok {
+		input.FileFormat = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("language_code"); ok {
+		input.LanguageCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_path"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3Path = expandS3Path(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra FAQ: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFaq(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+				tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra FAQ (%s): %w", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateFaqOutput).Id)
+
+	d.SetId(CreateResourceID(id, indexID))
+
+	if _, err := waitFaqCreated(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra FAQ (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceFaqRead(d, meta)
+}
+
+func resourceFaqRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	faq, err := FindFaqByID(conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra FAQ (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra FAQ (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/faq/%s", indexID, id),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(faq.CreatedAt).Format(time.RFC3339))
+	d.Set("description", faq.Description)
+	d.Set("error_message", faq.ErrorMessage)
+	d.Set("faq_id", faq.Id)
+	d.Set("file_format", faq.FileFormat)
+	d.Set("index_id", faq.IndexId)
+	d.Set("language_code", faq.LanguageCode)
+	d.Set("name", faq.Name)
+	d.Set("role_arn", faq.RoleArn)
+	if err := d.Set("s3_path", flattenS3Path(faq.S3Path)); err != nil {
+		return fmt.Errorf("error setting s3_path: %w", err)
+	}
+	d.Set("status", faq.Status)
+	d.Set("updated_at", aws.TimeValue(faq.UpdatedAt).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra FAQ (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFaqUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra FAQ (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFaqRead(d, meta)
+}
+
+func resourceFaqDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra FAQ: %s", d.Id())
+	_, err = conn.DeleteFaq(&kendra.DeleteFaqInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra FAQ (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitFaqDeleted(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra FAQ (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3Path(tfMap map[string]interface{}) *kendra.S3Path {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.S3Path{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Path(apiObject *kendra.S3Path) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket": aws.StringValue(apiObject.Bucket),
+		"key":    aws.StringValue(apiObject.Key),
+	}
+
+	return []interface{}{tfMap}
+}
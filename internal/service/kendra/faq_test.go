@@ -0,0 +1,258 @@
+package kendra_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraFaq_basic(t *testing.T) {
+	var faq kendra.DescribeFaqOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFaqDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFaqConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName, &faq),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/faq/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", kendra.FaqFileFormatCsv),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_path.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_path.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.FaqStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFaq_disappears(t *testing.T) {
+	var faq kendra.DescribeFaqOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFaqDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFaqConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName, &faq),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceFaq(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFaq_tags(t *testing.T) {
+	var faq kendra.DescribeFaqOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFaqDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFaqConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName, &faq),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFaqConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName, &faq),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFaqConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFaqExists(resourceName, &faq),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFaqExists(n string, v *kendra.DescribeFaqOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra FAQ ID is set")
+		}
+
+		id, indexID, err := tfkendra.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		output, err := tfkendra.FindFaqByID(conn, id, indexID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFaqDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_faq" {
+			continue
+		}
+
+		id, indexID, err := tfkendra.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindFaqByID(conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra FAQ %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFaqBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccIndexS3BaseConfig(rName), `
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "faq.csv"
+  content = "How many free clinics are in Spokane WA?,13,https://www.freeclinics.com/"
+}
+`)
+}
+
+func testAccFaqConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFaqBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName))
+}
+
+func testAccFaqConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFaqBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFaqConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFaqBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,138 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDataSourceByID(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeDataSourceOutput, error) {
+	input := &kendra.DescribeDataSourceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeDataSource(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindFaqByID(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeFaqOutput, error) {
+	input := &kendra.DescribeFaqInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeFaq(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindIndexByID(conn *kendra.Kendra, id string) (*kendra.DescribeIndexOutput, error) {
+	input := &kendra.DescribeIndexInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeIndex(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindQuerySuggestionsBlockListByID(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	input := &kendra.DescribeQuerySuggestionsBlockListInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeQuerySuggestionsBlockList(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindThesaurusByID(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeThesaurusOutput, error) {
+	input := &kendra.DescribeThesaurusInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeThesaurus(input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
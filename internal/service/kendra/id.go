@@ -0,0 +1,29 @@
+package kendra
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = "/"
+
+// CreateResourceID returns the ID of a resource that belongs to an index,
+// e.g. a data source, FAQ, thesaurus or query suggestions block list.
+func CreateResourceID(id, indexID string) string {
+	parts := []string{id, indexID}
+	resourceID := strings.Join(parts, resourceIDSeparator)
+
+	return resourceID
+}
+
+// ParseResourceID parses the ID of a resource that belongs to an index,
+// returning the resource's own ID and the index ID.
+func ParseResourceID(resourceID string) (string, string, error) {
+	parts := strings.Split(resourceID, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ID%[2]sINDEX_ID", resourceID, resourceIDSeparator)
+}
@@ -0,0 +1,67 @@
+package kendra_test
+
+import (
+	"testing"
+
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+)
+
+func TestParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		InputID         string
+		ExpectedID      string
+		ExpectedIndexID string
+		ExpectError     bool
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing index ID",
+			InputID:     "4fbc7c6c-5b0c-4ff7-b1b4-9e4c3e1f0a2d/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "a/b/c",
+			ExpectError: true,
+		},
+		{
+			TestName:        "valid",
+			InputID:         "4fbc7c6c-5b0c-4ff7-b1b4-9e4c3e1f0a2d/6a2fe2f4-0f4e-4e3b-8c7a-2b9d5b1c3e4f",
+			ExpectedID:      "4fbc7c6c-5b0c-4ff7-b1b4-9e4c3e1f0a2d",
+			ExpectedIndexID: "6a2fe2f4-0f4e-4e3b-8c7a-2b9d5b1c3e4f",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotID, gotIndexID, err := tfkendra.ParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", gotID, testCase.ExpectedID)
+			}
+
+			if gotIndexID != testCase.ExpectedIndexID {
+				t.Errorf("got index ID %s, expected %s", gotIndexID, testCase.ExpectedIndexID)
+			}
+
+			if !testCase.ExpectError {
+				if got := tfkendra.CreateResourceID(gotID, gotIndexID); got != testCase.InputID {
+					t.Errorf("got ID %s after round trip, expected %s", got, testCase.InputID)
+				}
+			}
+		})
+	}
+}
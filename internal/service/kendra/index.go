@@ -0,0 +1,751 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIndexCreate,
+		Read:   resourceIndexRead,
+		Update: resourceIndexUpdate,
+		Delete: resourceIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"edition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kendra.IndexEditionEnterpriseEdition,
+				ValidateFunc: validation.StringInSlice(kendra.IndexEdition_Values(), false),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"faq_statistics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"indexed_question_answers_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"text_document_statistics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"indexed_text_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"indexed_text_documents_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1000),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "must begin with a letter or number and contain only alphanumeric, underscore, or hyphen characters"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_context_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kendra.UserContextPolicyAttributeFilter,
+				ValidateFunc: validation.StringInSlice(kendra.UserContextPolicy_Values(), false),
+			},
+			"user_group_resolution_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_group_resolution_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kendra.UserGroupResolutionMode_Values(), false),
+						},
+					},
+				},
+			},
+			"user_token_configurations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_token_type_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_attribute_field": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"user_name_attribute_field": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"jwt_token_type_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"group_attribute_field": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"issuer": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 65),
+									},
+									"key_location": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(kendra.KeyLocation_Values(), false),
+									},
+									"secrets_manager_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									"user_name_attribute_field": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateIndexInput{
+		Edition:           aws.String(d.Get("edition").(string)),
+		Name:              aws.String(name),
+		RoleArn:           aws.String(d.Get("role_arn").(string)),
+		UserContextPolicy: aws.String(d.Get("user_context_policy").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_group_resolution_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.UserGroupResolutionConfiguration = expandUserGroupResolutionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_token_configurations"); ok && len(v.([]interface{})) > 0 {
+		input.UserTokenConfigurations = expandUserTokenConfigurations(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Index: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateIndex(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+				tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Unable to assume role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Index (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*kendra.CreateIndexOutput).Id))
+
+	if _, err := waitIndexCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Index (%s) create: %w", d.Id(), err)
+	}
+
+	// CapacityUnits can only be set on UpdateIndex.
+	if v, ok := d.GetOk("capacity_units"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &kendra.UpdateIndexInput{
+			CapacityUnits: expandCapacityUnitsConfiguration(v.([]interface{})[0].(map[string]interface{})),
+			Id:            aws.String(d.Id()),
+		}
+
+		if err := updateIndex(conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceIndexRead(d, meta)
+}
+
+func resourceIndexRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	index, err := FindIndexByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Index (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Index (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("capacity_units", flattenCapacityUnitsConfiguration(index.CapacityUnits)); err != nil {
+		return fmt.Errorf("error setting capacity_units: %w", err)
+	}
+	d.Set("created_at", aws.TimeValue(index.CreatedAt).Format(time.RFC3339))
+	d.Set("description", index.Description)
+	d.Set("edition", index.Edition)
+	d.Set("error_message", index.ErrorMessage)
+	if err := d.Set("index_statistics", flattenIndexStatistics(index.IndexStatistics)); err != nil {
+		return fmt.Errorf("error setting index_statistics: %w", err)
+	}
+	d.Set("name", index.Name)
+	d.Set("role_arn", index.RoleArn)
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(index.ServerSideEncryptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+	}
+	d.Set("status", index.Status)
+	d.Set("updated_at", aws.TimeValue(index.UpdatedAt).Format(time.RFC3339))
+	d.Set("user_context_policy", index.UserContextPolicy)
+	if err := d.Set("user_group_resolution_configuration", flattenUserGroupResolutionConfiguration(index.UserGroupResolutionConfiguration)); err != nil {
+		return fmt.Errorf("error setting user_group_resolution_configuration: %w", err)
+	}
+	if err := d.Set("user_token_configurations", flattenUserTokenConfigurations(index.UserTokenConfigurations)); err != nil {
+		return fmt.Errorf("error setting user_token_configurations: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra Index (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &kendra.UpdateIndexInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capacity_units") {
+			if v, ok := d.GetOk("capacity_units"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CapacityUnits = expandCapacityUnitsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("user_context_policy") {
+			input.UserContextPolicy = aws.String(d.Get("user_context_policy").(string))
+		}
+
+		if d.HasChange("user_group_resolution_configuration") {
+			if v, ok := d.GetOk("user_group_resolution_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.UserGroupResolutionConfiguration = expandUserGroupResolutionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("user_token_configurations") {
+			input.UserTokenConfigurations = expandUserTokenConfigurations(d.Get("user_token_configurations").([]interface{}))
+		}
+
+		if err := updateIndex(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra Index (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceIndexRead(d, meta)
+}
+
+func resourceIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	log.Printf("[DEBUG] Deleting Kendra Index: %s", d.Id())
+	_, err := conn.DeleteIndex(&kendra.DeleteIndexInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Index (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitIndexDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kendra Index (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateIndex(conn *kendra.Kendra, input *kendra.UpdateIndexInput, timeout time.Duration) error {
+	id := aws.StringValue(input.Id)
+
+	log.Printf("[DEBUG] Updating Kendra Index: %s", input)
+	_, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateIndex(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+				tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Unable to assume role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error updating Kendra Index (%s): %w", id, err)
+	}
+
+	if _, err := waitIndexUpdated(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kendra Index (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandCapacityUnitsConfiguration(tfMap map[string]interface{}) *kendra.CapacityUnitsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.CapacityUnitsConfiguration{}
+
+	if v, ok := tfMap["query_capacity_units"].(int); ok {
+		apiObject.QueryCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_capacity_units"].(int); ok {
+		apiObject.StorageCapacityUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandServerSideEncryptionConfiguration(tfMap map[string]interface{}) *kendra.ServerSideEncryptionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.ServerSideEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUserGroupResolutionConfiguration(tfMap map[string]interface{}) *kendra.UserGroupResolutionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.UserGroupResolutionConfiguration{}
+
+	if v, ok := tfMap["user_group_resolution_mode"].(string); ok && v != "" {
+		apiObject.UserGroupResolutionMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUserTokenConfigurations(tfList []interface{}) []*kendra.UserTokenConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*kendra.UserTokenConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kendra.UserTokenConfiguration{}
+
+		if v, ok := tfMap["json_token_type_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.JsonTokenTypeConfiguration = expandJSONTokenTypeConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["jwt_token_type_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.JwtTokenTypeConfiguration = expandJWTTokenTypeConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandJSONTokenTypeConfiguration(tfMap map[string]interface{}) *kendra.JsonTokenTypeConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.JsonTokenTypeConfiguration{}
+
+	if v, ok := tfMap["group_attribute_field"].(string); ok && v != "" {
+		apiObject.GroupAttributeField = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_name_attribute_field"].(string); ok && v != "" {
+		apiObject.UserNameAttributeField = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJWTTokenTypeConfiguration(tfMap map[string]interface{}) *kendra.JwtTokenTypeConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kendra.JwtTokenTypeConfiguration{}
+
+	if v, ok := tfMap["claim_regex"].(string); ok && v != "" {
+		apiObject.ClaimRegex = aws.String(v)
+	}
+
+	if v, ok := tfMap["group_attribute_field"].(string); ok && v != "" {
+		apiObject.GroupAttributeField = aws.String(v)
+	}
+
+	if v, ok := tfMap["issuer"].(string); ok && v != "" {
+		apiObject.Issuer = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_location"].(string); ok && v != "" {
+		apiObject.KeyLocation = aws.String(v)
+	}
+
+	if v, ok := tfMap["secrets_manager_arn"].(string); ok && v != "" {
+		apiObject.SecretManagerArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.URL = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_name_attribute_field"].(string); ok && v != "" {
+		apiObject.UserNameAttributeField = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCapacityUnitsConfiguration(apiObject *kendra.CapacityUnitsConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"query_capacity_units":   aws.Int64Value(apiObject.QueryCapacityUnits),
+		"storage_capacity_units": aws.Int64Value(apiObject.StorageCapacityUnits),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIndexStatistics(apiObject *kendra.IndexStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FaqStatistics; v != nil {
+		tfMap["faq_statistics"] = []interface{}{
+			map[string]interface{}{
+				"indexed_question_answers_count": aws.Int64Value(v.IndexedQuestionAnswersCount),
+			},
+		}
+	}
+
+	if v := apiObject.TextDocumentStatistics; v != nil {
+		tfMap["text_document_statistics"] = []interface{}{
+			map[string]interface{}{
+				"indexed_text_bytes":           aws.Int64Value(v.IndexedTextBytes),
+				"indexed_text_documents_count": aws.Int64Value(v.IndexedTextDocumentsCount),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServerSideEncryptionConfiguration(apiObject *kendra.ServerSideEncryptionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserGroupResolutionConfiguration(apiObject *kendra.UserGroupResolutionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"user_group_resolution_mode": aws.StringValue(apiObject.UserGroupResolutionMode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserTokenConfigurations(apiObjects []*kendra.UserTokenConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.JsonTokenTypeConfiguration; v != nil {
+			tfMap["json_token_type_configuration"] = []interface{}{
+				map[string]interface{}{
+					"group_attribute_field":     aws.StringValue(v.GroupAttributeField),
+					"user_name_attribute_field": aws.StringValue(v.UserNameAttributeField),
+				},
+			}
+		}
+
+		if v := apiObject.JwtTokenTypeConfiguration; v != nil {
+			tfMap["jwt_token_type_configuration"] = []interface{}{
+				map[string]interface{}{
+					"claim_regex":               aws.StringValue(v.ClaimRegex),
+					"group_attribute_field":     aws.StringValue(v.GroupAttributeField),
+					"issuer":                    aws.StringValue(v.Issuer),
+					"key_location":              aws.StringValue(v.KeyLocation),
+					"secrets_manager_arn":       aws.StringValue(v.SecretManagerArn),
+					"url":                       aws.StringValue(v.URL),
+					"user_name_attribute_field": aws.StringValue(v.UserNameAttributeField),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,330 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQuerySuggestionsBlockList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQuerySuggestionsBlockListCreate,
+		Read:   resourceQuerySuggestionsBlockListRead,
+		Update: resourceQuerySuggestionsBlockListUpdate,
+		Delete: resourceQuerySuggestionsBlockListDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_suggestions_block_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_s3_path": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceQuerySuggestionsBlockListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	indexID := d.Get("index_id").(string)
+	name := d.Get("name").(string)
+	input := &kendra.CreateQuerySuggestionsBlockListInput{
+		IndexId: aws.String(indexID),
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_s3_path"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceS3Path = expandS3Path(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Query Suggestions Block List: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateQuerySuggestionsBlockList(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+				tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Query Suggestions Block List (%s): %w", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateQuerySuggestionsBlockListOutput).Id)
+
+	d.SetId(CreateResourceID(id, indexID))
+
+	if _, err := waitQuerySuggestionsBlockListCreated(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra Query Suggestions Block List (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceQuerySuggestionsBlockListRead(d, meta)
+}
+
+func resourceQuerySuggestionsBlockListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	blockList, err := FindQuerySuggestionsBlockListByID(conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Query Suggestions Block List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/query-suggestions-block-list/%s", indexID, id),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(blockList.CreatedAt).Format(time.RFC3339))
+	d.Set("description", blockList.Description)
+	d.Set("error_message", blockList.ErrorMessage)
+	d.Set("file_size_bytes", blockList.FileSizeBytes)
+	d.Set("index_id", blockList.IndexId)
+	d.Set("item_count", blockList.ItemCount)
+	d.Set("name", blockList.Name)
+	d.Set("query_suggestions_block_list_id", blockList.Id)
+	d.Set("role_arn", blockList.RoleArn)
+	if err := d.Set("source_s3_path", flattenS3Path(blockList.SourceS3Path)); err != nil {
+		return fmt.Errorf("error setting source_s3_path: %w", err)
+	}
+	d.Set("status", blockList.Status)
+	d.Set("updated_at", aws.TimeValue(blockList.UpdatedAt).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceQuerySuggestionsBlockListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexID, err := ParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &kendra.UpdateQuerySuggestionsBlockListInput{
+			Id:      aws.String(id),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source_s3_path") {
+			input.SourceS3Path = expandS3Path(d.Get("source_s3_path").([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Query Suggestions Block List: %s", input)
+		_, err = tfresource.RetryWhen(
+			tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateQuerySuggestionsBlockList(input)
+			},
+			func(err error) (bool, error) {
+				if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+					tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitQuerySuggestionsBlockListUpdated(conn, id, indexID); err != nil {
+			return fmt.Errorf("error waiting for Kendra Query Suggestions Block List (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra Query Suggestions Block List (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceQuerySuggestionsBlockListRead(d, meta)
+}
+
+func resourceQuerySuggestionsBlockListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Query Suggestions Block List: %s", d.Id())
+	_, err = conn.DeleteQuerySuggestionsBlockList(&kendra.DeleteQuerySuggestionsBlockListInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Query Suggestions Block List (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitQuerySuggestionsBlockListDeleted(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra Query Suggestions Block List (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package kendra_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraQuerySuggestionsBlockList_basic(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/query-suggestions-block-list/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.QuerySuggestionsBlockListStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_suggestions_block_list_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_disappears(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceQuerySuggestionsBlockList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_name(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfig(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_tags(t *testing.T) {
+	var blockList kendra.DescribeQuerySuggestionsBlockListOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kendra.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName, &blockList),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuerySuggestionsBlockListExists(n string, v *kendra.DescribeQuerySuggestionsBlockListOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Query Suggestions Block List ID is set")
+		}
+
+		id, indexID, err := tfkendra.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		output, err := tfkendra.FindQuerySuggestionsBlockListByID(conn, id, indexID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuerySuggestionsBlockListDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_query_suggestions_block_list" {
+			continue
+		}
+
+		id, indexID, err := tfkendra.ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindQuerySuggestionsBlockListByID(conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Query Suggestions Block List %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccQuerySuggestionsBlockListBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccIndexS3BaseConfig(rName), `
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "block-list.txt"
+  content = "blocked phrase"
+}
+`)
+}
+
+func testAccQuerySuggestionsBlockListConfig(rName, name string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, name))
+}
+
+func testAccQuerySuggestionsBlockListConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccQuerySuggestionsBlockListConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_bucket_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
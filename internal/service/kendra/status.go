@@ -0,0 +1,88 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDataSource(conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataSourceByID(conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusFaq(conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFaqByID(conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusIndex(conn *kendra.Kendra, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindIndexByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusQuerySuggestionsBlockList(conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindQuerySuggestionsBlockListByID(conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusThesaurus(conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindThesaurusByID(conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
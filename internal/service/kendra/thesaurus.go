@@ -0,0 +1,335 @@
+package kendra
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceThesaurus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceThesaurusCreate,
+		Read:   resourceThesaurusRead,
+		Update: resourceThesaurusUpdate,
+		Delete: resourceThesaurusDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_s3_path": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"synonym_rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"term_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"thesaurus_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceThesaurusCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	indexID := d.Get("index_id").(string)
+	name := d.Get("name").(string)
+	input := &kendra.CreateThesaurusInput{
+		IndexId: aws.String(indexID),
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_s3_path"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceS3Path = expandS3Path(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Thesaurus: %s", input)
+	outputRaw, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateThesaurus(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+				tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kendra Thesaurus (%s): %w", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateThesaurusOutput).Id)
+
+	d.SetId(CreateResourceID(id, indexID))
+
+	if _, err := waitThesaurusCreated(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra Thesaurus (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceThesaurusRead(d, meta)
+}
+
+func resourceThesaurusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	thesaurus, err := FindThesaurusByID(conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Thesaurus (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kendra Thesaurus (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "kendra",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/thesaurus/%s", indexID, id),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(thesaurus.CreatedAt).Format(time.RFC3339))
+	d.Set("description", thesaurus.Description)
+	d.Set("error_message", thesaurus.ErrorMessage)
+	d.Set("file_size_bytes", thesaurus.FileSizeBytes)
+	d.Set("index_id", thesaurus.IndexId)
+	d.Set("name", thesaurus.Name)
+	d.Set("role_arn", thesaurus.RoleArn)
+	if err := d.Set("source_s3_path", flattenS3Path(thesaurus.SourceS3Path)); err != nil {
+		return fmt.Errorf("error setting source_s3_path: %w", err)
+	}
+	d.Set("status", thesaurus.Status)
+	d.Set("synonym_rule_count", thesaurus.SynonymRuleCount)
+	d.Set("term_count", thesaurus.TermCount)
+	d.Set("thesaurus_id", thesaurus.Id)
+	d.Set("updated_at", aws.TimeValue(thesaurus.UpdatedAt).Format(time.RFC3339))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kendra Thesaurus (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceThesaurusUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexID, err := ParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &kendra.UpdateThesaurusInput{
+			Id:      aws.String(id),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source_s3_path") {
+			input.SourceS3Path = expandS3Path(d.Get("source_s3_path").([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Thesaurus: %s", input)
+		_, err = tfresource.RetryWhen(
+			tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateThesaurus(input)
+			},
+			func(err error) (bool, error) {
+				if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity") ||
+					tfawserr.ErrCodeEquals(err, kendra.ErrCodeConflictException) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kendra Thesaurus (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitThesaurusUpdated(conn, id, indexID); err != nil {
+			return fmt.Errorf("error waiting for Kendra Thesaurus (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kendra Thesaurus (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceThesaurusRead(d, meta)
+}
+
+func resourceThesaurusDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Thesaurus: %s", d.Id())
+	_, err = conn.DeleteThesaurus(&kendra.DeleteThesaurusInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kendra Thesaurus (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitThesaurusDeleted(conn, id, indexID); err != nil {
+		return fmt.Errorf("error waiting for Kendra Thesaurus (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
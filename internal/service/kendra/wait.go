@@ -0,0 +1,295 @@
+package kendra
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	dataSourceCreatedTimeout = 30 * time.Minute
+	dataSourceUpdatedTimeout = 30 * time.Minute
+	dataSourceDeletedTimeout = 30 * time.Minute
+
+	faqCreatedTimeout = 30 * time.Minute
+	faqDeletedTimeout = 30 * time.Minute
+
+	querySuggestionsBlockListCreatedTimeout = 30 * time.Minute
+	querySuggestionsBlockListUpdatedTimeout = 30 * time.Minute
+	querySuggestionsBlockListDeletedTimeout = 30 * time.Minute
+
+	thesaurusCreatedTimeout = 30 * time.Minute
+	thesaurusUpdatedTimeout = 30 * time.Minute
+	thesaurusDeletedTimeout = 30 * time.Minute
+)
+
+func waitDataSourceCreated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeDataSourceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.DataSourceStatusCreating},
+		Target:  []string{kendra.DataSourceStatusActive},
+		Refresh: statusDataSource(conn, id, indexID),
+		Timeout: dataSourceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceUpdated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeDataSourceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.DataSourceStatusUpdating},
+		Target:  []string{kendra.DataSourceStatusActive},
+		Refresh: statusDataSource(conn, id, indexID),
+		Timeout: dataSourceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceDeleted(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeDataSourceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.DataSourceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDataSource(conn, id, indexID),
+		Timeout: dataSourceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFaqCreated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeFaqOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.FaqStatusCreating},
+		Target:  []string{kendra.FaqStatusActive},
+		Refresh: statusFaq(conn, id, indexID),
+		Timeout: faqCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFaqDeleted(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeFaqOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.FaqStatusDeleting},
+		Target:  []string{},
+		Refresh: statusFaq(conn, id, indexID),
+		Timeout: faqDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexCreated(conn *kendra.Kendra, id string, timeout time.Duration) (*kendra.DescribeIndexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.IndexStatusCreating},
+		Target:  []string{kendra.IndexStatusActive},
+		Refresh: statusIndex(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexUpdated(conn *kendra.Kendra, id string, timeout time.Duration) (*kendra.DescribeIndexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.IndexStatusUpdating},
+		Target:  []string{kendra.IndexStatusActive},
+		Refresh: statusIndex(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexDeleted(conn *kendra.Kendra, id string, timeout time.Duration) (*kendra.DescribeIndexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.IndexStatusDeleting},
+		Target:  []string{},
+		Refresh: statusIndex(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListCreated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusCreating},
+		Target:  []string{kendra.QuerySuggestionsBlockListStatusActive},
+		Refresh: statusQuerySuggestionsBlockList(conn, id, indexID),
+		Timeout: querySuggestionsBlockListCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListUpdated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusUpdating},
+		Target:  []string{kendra.QuerySuggestionsBlockListStatusActive},
+		Refresh: statusQuerySuggestionsBlockList(conn, id, indexID),
+		Timeout: querySuggestionsBlockListUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListDeleted(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusDeleting},
+		Target:  []string{},
+		Refresh: statusQuerySuggestionsBlockList(conn, id, indexID),
+		Timeout: querySuggestionsBlockListDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusCreated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusCreating},
+		Target:  []string{kendra.ThesaurusStatusActive},
+		Refresh: statusThesaurus(conn, id, indexID),
+		Timeout: thesaurusCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusUpdated(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusUpdating},
+		Target:  []string{kendra.ThesaurusStatusActive},
+		Refresh: statusThesaurus(conn, id, indexID),
+		Timeout: thesaurusUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusDeleted(conn *kendra.Kendra, id, indexID string) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusDeleting},
+		Target:  []string{},
+		Refresh: statusThesaurus(conn, id, indexID),
+		Timeout: thesaurusDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -43,3 +43,38 @@ func FindEventSourceMappingConfiguration(conn *lambda.Lambda, input *lambda.GetE
 
 	return output, nil
 }
+
+// FindFunctionURLByNameAndQualifier returns the function URL configuration corresponding to the specified function name and qualifier.
+// Returns NotFoundError if no function URL configuration is found.
+func FindFunctionURLByNameAndQualifier(conn *lambda.Lambda, name, qualifier string) (*lambda.GetFunctionUrlConfigOutput, error) {
+	input := &lambda.GetFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetFunctionUrlConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle any empty result.
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
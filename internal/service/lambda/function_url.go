@@ -0,0 +1,456 @@
+package lambda
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Statement ID of the resource-based policy statement that allows public access
+	// to a function URL whose authorization type is NONE.
+	// This differs from the "FunctionURLAllowPublicAccess" statement added by the console,
+	// so that only statements added by this resource are removed by it.
+	functionURLPublicAccessStatementID = "TerraformFunctionURLAllowPublicAccess"
+)
+
+func ResourceFunctionURL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFunctionURLCreate,
+		Read:   resourceFunctionURLRead,
+		Update: resourceFunctionURLUpdate,
+		Delete: resourceFunctionURLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authorization_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lambda.FunctionUrlAuthType_Values(), false),
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"allow_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_methods": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_origins": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtMost(86400),
+						},
+					},
+				},
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"url_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFunctionURLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := FunctionURLCreateResourceID(name, qualifier)
+	authorizationType := d.Get("authorization_type").(string)
+	input := &lambda.CreateFunctionUrlConfigInput{
+		AuthType:     aws.String(authorizationType),
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lambda Function URL: %s", input)
+	_, err := conn.CreateFunctionUrlConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lambda Function URL (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if authorizationType == lambda.FunctionUrlAuthTypeNone {
+		if err := addFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+			return fmt.Errorf("error adding public access permission for Lambda Function URL (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceFunctionURLRead(d, meta)
+}
+
+func resourceFunctionURLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindFunctionURLByNameAndQualifier(conn, name, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function URL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	functionURL := aws.StringValue(output.FunctionUrl)
+
+	d.Set("authorization_type", output.AuthType)
+	if output.Cors != nil {
+		if err := d.Set("cors", []interface{}{flattenFunctionURLCors(output.Cors)}); err != nil {
+			return fmt.Errorf("error setting cors: %w", err)
+		}
+	} else {
+		d.Set("cors", nil)
+	}
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", name)
+	d.Set("function_url", functionURL)
+	d.Set("qualifier", qualifier)
+	d.Set("url_id", functionURLID(functionURL))
+
+	return nil
+}
+
+func resourceFunctionURLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if d.HasChange("authorization_type") {
+		input.AuthType = aws.String(d.Get("authorization_type").(string))
+	}
+
+	if d.HasChange("cors") {
+		if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Cors = &lambda.Cors{}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Lambda Function URL: %s", input)
+	_, err = conn.UpdateFunctionUrlConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	if d.HasChange("authorization_type") {
+		if d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+			if err := addFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+				return fmt.Errorf("error adding public access permission for Lambda Function URL (%s): %w", d.Id(), err)
+			}
+		} else {
+			if err := removeFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+				return fmt.Errorf("error removing public access permission for Lambda Function URL (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceFunctionURLRead(d, meta)
+}
+
+func resourceFunctionURLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.DeleteFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Function URL: %s", d.Id())
+	_, err = conn.DeleteFunctionUrlConfig(input)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return fmt.Errorf("error deleting Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	// The permission is removed even if the function URL no longer exists.
+	if err := removeFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+		return fmt.Errorf("error removing public access permission for Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const functionURLResourceIDSeparator = "/"
+
+func FunctionURLCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, functionURLResourceIDSeparator)
+
+	return id
+}
+
+func FunctionURLParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, functionURLResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME or FUNCTION-NAME%[2]sQUALIFIER", id, functionURLResourceIDSeparator)
+}
+
+// addFunctionURLPublicAccessPermission adds the resource-based policy statement
+// required for unauthenticated invocation of a function URL.
+func addFunctionURLPublicAccessPermission(conn *lambda.Lambda, name, qualifier string) error {
+	// The statement may already exist, e.g. after a previous partial apply.
+	_, err := findFunctionURLPublicAccessPermission(conn, name, qualifier)
+
+	if err == nil {
+		return nil
+	}
+
+	if !tfresource.NotFound(err) {
+		return err
+	}
+
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: aws.String(lambda.FunctionUrlAuthTypeNone),
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
+	_, err = tfresource.RetryWhen(
+		functionURLPermissionTimeout,
+		func() (interface{}, error) {
+			return conn.AddPermission(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "in progress") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	// The statement was added concurrently since it was looked up.
+	if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "already exists") {
+		return nil
+	}
+
+	return err
+}
+
+// findFunctionURLPublicAccessPermission returns the resource-based policy statement
+// that allows public access to the specified function URL.
+func findFunctionURLPublicAccessPermission(conn *lambda.Lambda, name, qualifier string) (*PolicyStatement, error) {
+	input := &lambda.GetPolicyInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return getLambdaPolicyStatement(output, functionURLPublicAccessStatementID)
+}
+
+func removeFunctionURLPublicAccessPermission(conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Removing Lambda Permission: %s", input)
+	_, err := conn.RemovePermission(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// functionURLID returns the URL ID, the first DNS label of the function URL's host.
+func functionURLID(functionURL string) string {
+	u, err := url.Parse(functionURL)
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.Split(u.Hostname(), ".")[0]
+}
+
+func expandFunctionURLCors(tfMap map[string]interface{}) *lambda.Cors {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.Cors{}
+
+	if v, ok := tfMap["allow_credentials"].(bool); ok {
+		apiObject.AllowCredentials = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_methods"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowMethods = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_origins"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowOrigins = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["expose_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExposeHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["max_age"].(int); ok && v != 0 {
+		apiObject.MaxAge = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFunctionURLCors(apiObject *lambda.Cors) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_credentials": aws.BoolValue(apiObject.AllowCredentials),
+		"allow_headers":     aws.StringValueSlice(apiObject.AllowHeaders),
+		"allow_methods":     aws.StringValueSlice(apiObject.AllowMethods),
+		"allow_origins":     aws.StringValueSlice(apiObject.AllowOrigins),
+		"expose_headers":    aws.StringValueSlice(apiObject.ExposeHeaders),
+		"max_age":           aws.Int64Value(apiObject.MaxAge),
+	}
+
+	return tfMap
+}
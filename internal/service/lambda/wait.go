@@ -18,6 +18,8 @@ const (
 	lambdaFunctionExtraThrottlingTimeout = 9 * time.Minute
 
 	eventSourceMappingPropagationTimeout = 5 * time.Minute
+
+	functionURLPermissionTimeout = 2 * time.Minute
 )
 
 func waitEventSourceMappingCreate(conn *lambda.Lambda, id string) (*lambda.EventSourceMappingConfiguration, error) {
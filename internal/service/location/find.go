@@ -0,0 +1,176 @@
+package location
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGeofenceCollectionByName(conn *locationservice.LocationService, name string) (*locationservice.DescribeGeofenceCollectionOutput, error) {
+	input := &locationservice.DescribeGeofenceCollectionInput{
+		CollectionName: aws.String(name),
+	}
+
+	output, err := conn.DescribeGeofenceCollection(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMapByName(conn *locationservice.LocationService, name string) (*locationservice.DescribeMapOutput, error) {
+	input := &locationservice.DescribeMapInput{
+		MapName: aws.String(name),
+	}
+
+	output, err := conn.DescribeMap(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindPlaceIndexByName(conn *locationservice.LocationService, name string) (*locationservice.DescribePlaceIndexOutput, error) {
+	input := &locationservice.DescribePlaceIndexInput{
+		IndexName: aws.String(name),
+	}
+
+	output, err := conn.DescribePlaceIndex(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRouteCalculatorByName(conn *locationservice.LocationService, name string) (*locationservice.DescribeRouteCalculatorOutput, error) {
+	input := &locationservice.DescribeRouteCalculatorInput{
+		CalculatorName: aws.String(name),
+	}
+
+	output, err := conn.DescribeRouteCalculator(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTrackerByName(conn *locationservice.LocationService, name string) (*locationservice.DescribeTrackerOutput, error) {
+	input := &locationservice.DescribeTrackerInput{
+		TrackerName: aws.String(name),
+	}
+
+	output, err := conn.DescribeTracker(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTrackerAssociationByTrackerNameAndConsumerARN(conn *locationservice.LocationService, trackerName, consumerARN string) error {
+	input := &locationservice.ListTrackerConsumersInput{
+		TrackerName: aws.String(trackerName),
+	}
+	found := false
+
+	err := conn.ListTrackerConsumersPages(input, func(page *locationservice.ListTrackerConsumersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConsumerArns {
+			if aws.StringValue(v) == consumerARN {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
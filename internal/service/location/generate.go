@@ -0,0 +1,4 @@
+//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -UpdateTags
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package location
@@ -0,0 +1,185 @@
+package location
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGeofenceCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGeofenceCollectionCreate,
+		Read:   resourceGeofenceCollectionRead,
+		Update: resourceGeofenceCollectionUpdate,
+		Delete: resourceGeofenceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"collection_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGeofenceCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("collection_name").(string)
+	input := &locationservice.CreateGeofenceCollectionInput{
+		CollectionName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Geofence Collection: %s", input)
+	_, err := conn.CreateGeofenceCollection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Location Service Geofence Collection (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceGeofenceCollectionRead(d, meta)
+}
+
+func resourceGeofenceCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindGeofenceCollectionByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Geofence Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Location Service Geofence Collection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("collection_arn", output.CollectionArn)
+	d.Set("collection_name", output.CollectionName)
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGeofenceCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChange("description") {
+		input := &locationservice.UpdateGeofenceCollectionInput{
+			CollectionName: aws.String(d.Id()),
+			Description:    aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Geofence Collection: %s", input)
+		_, err := conn.UpdateGeofenceCollection(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Location Service Geofence Collection (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("collection_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Location Service Geofence Collection (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGeofenceCollectionRead(d, meta)
+}
+
+func resourceGeofenceCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Geofence Collection: %s", d.Id())
+	_, err := conn.DeleteGeofenceCollection(&locationservice.DeleteGeofenceCollectionInput{
+		CollectionName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Location Service Geofence Collection (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
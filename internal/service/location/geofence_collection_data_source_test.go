@@ -0,0 +1,45 @@
+package location_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLocationGeofenceCollectionDataSource_collectionName(t *testing.T) {
+	dataSourceName := "data.aws_location_geofence_collection.test"
+	resourceName := "aws_location_geofence_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(locationservice.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGeofenceCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceCollectionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "collection_arn", resourceName, "collection_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "collection_name", resourceName, "collection_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "create_time", resourceName, "create_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "update_time", resourceName, "update_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGeofenceCollectionDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccGeofenceCollectionConfig(rName), `
+data "aws_location_geofence_collection" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+}
+`)
+}
@@ -0,0 +1,25 @@
+package location
+
+import (
+	"fmt"
+	"strings"
+)
+
+const trackerAssociationIDSeparator = "|"
+
+func TrackerAssociationCreateID(trackerName, consumerARN string) string {
+	parts := []string{trackerName, consumerARN}
+	id := strings.Join(parts, trackerAssociationIDSeparator)
+
+	return id
+}
+
+func TrackerAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, trackerAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TRACKER_NAME%[2]sCONSUMER_ARN", id, trackerAssociationIDSeparator)
+}
@@ -0,0 +1,67 @@
+package location_test
+
+import (
+	"testing"
+
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+)
+
+func TestTrackerAssociationParseID(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectedTrackerName string
+		ExpectedConsumerARN string
+		ExpectError         bool
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing consumer ARN",
+			InputID:     "example|",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "a|b|c",
+			ExpectError: true,
+		},
+		{
+			TestName:            "valid",
+			InputID:             "example|arn:aws:geo:us-west-2:123456789012:geofence-collection/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedTrackerName: "example",
+			ExpectedConsumerARN: "arn:aws:geo:us-west-2:123456789012:geofence-collection/example", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotTrackerName, gotConsumerARN, err := tflocation.TrackerAssociationParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotTrackerName != testCase.ExpectedTrackerName {
+				t.Errorf("got tracker name %s, expected %s", gotTrackerName, testCase.ExpectedTrackerName)
+			}
+
+			if gotConsumerARN != testCase.ExpectedConsumerARN {
+				t.Errorf("got consumer ARN %s, expected %s", gotConsumerARN, testCase.ExpectedConsumerARN)
+			}
+
+			if !testCase.ExpectError {
+				if got := tflocation.TrackerAssociationCreateID(gotTrackerName, gotConsumerARN); got != testCase.InputID {
+					t.Errorf("got ID %s after round trip, expected %s", got, testCase.InputID)
+				}
+			}
+		})
+	}
+}
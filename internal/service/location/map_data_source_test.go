@@ -0,0 +1,46 @@
+package location_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLocationMapDataSource_mapName(t *testing.T) {
+	dataSourceName := "data.aws_location_map.test"
+	resourceName := "aws_location_map.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(locationservice.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMapDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.#", resourceName, "configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.style", resourceName, "configuration.0.style"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "create_time", resourceName, "create_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "map_arn", resourceName, "map_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "map_name", resourceName, "map_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "update_time", resourceName, "update_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMapDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccMapConfig(rName), `
+data "aws_location_map" "test" {
+  map_name = aws_location_map.test.map_name
+}
+`)
+}
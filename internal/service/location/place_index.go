@@ -0,0 +1,245 @@
+package location
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePlaceIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePlaceIndexCreate,
+		Read:   resourcePlaceIndexRead,
+		Update: resourcePlaceIndexUpdate,
+		Delete: resourcePlaceIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_source_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intended_use": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      locationservice.IntendedUseSingleUse,
+							ValidateFunc: validation.StringInSlice(locationservice.IntendedUse_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"index_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePlaceIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("index_name").(string)
+	input := &locationservice.CreatePlaceIndexInput{
+		DataSource: aws.String(d.Get("data_source").(string)),
+		IndexName:  aws.String(name),
+	}
+
+	if v, ok := d.GetOk("data_source_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSourceConfiguration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Place Index: %s", input)
+	_, err := conn.CreatePlaceIndex(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Location Service Place Index (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePlaceIndexRead(d, meta)
+}
+
+func resourcePlaceIndexRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPlaceIndexByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Place Index (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Location Service Place Index (%s): %w", d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("data_source", output.DataSource)
+	if output.DataSourceConfiguration != nil {
+		if err := d.Set("data_source_configuration", []interface{}{flattenDataSourceConfiguration(output.DataSourceConfiguration)}); err != nil {
+			return fmt.Errorf("error setting data_source_configuration: %w", err)
+		}
+	} else {
+		d.Set("data_source_configuration", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("index_arn", output.IndexArn)
+	d.Set("index_name", output.IndexName)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePlaceIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("data_source_configuration", "description") {
+		input := &locationservice.UpdatePlaceIndexInput{
+			IndexName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_source_configuration") {
+			if v, ok := d.GetOk("data_source_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DataSourceConfiguration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Place Index: %s", input)
+		_, err := conn.UpdatePlaceIndex(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Location Service Place Index (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("index_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Location Service Place Index (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePlaceIndexRead(d, meta)
+}
+
+func resourcePlaceIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Place Index: %s", d.Id())
+	_, err := conn.DeletePlaceIndex(&locationservice.DeletePlaceIndexInput{
+		IndexName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Location Service Place Index (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataSourceConfiguration(tfMap map[string]interface{}) *locationservice.DataSourceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.DataSourceConfiguration{}
+
+	if v, ok := tfMap["intended_use"].(string); ok && v != "" {
+		apiObject.IntendedUse = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataSourceConfiguration(apiObject *locationservice.DataSourceConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IntendedUse; v != nil {
+		tfMap["intended_use"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
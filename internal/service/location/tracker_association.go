@@ -0,0 +1,118 @@
+package location
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrackerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrackerAssociationCreate,
+		Read:   resourceTrackerAssociationRead,
+		Delete: resourceTrackerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tracker_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceTrackerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName := d.Get("tracker_name").(string)
+	consumerARN := d.Get("consumer_arn").(string)
+	id := TrackerAssociationCreateID(trackerName, consumerARN)
+	input := &locationservice.AssociateTrackerConsumerInput{
+		ConsumerArn: aws.String(consumerARN),
+		TrackerName: aws.String(trackerName),
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Tracker Association: %s", input)
+	_, err := conn.AssociateTrackerConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Location Service Tracker Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTrackerAssociationRead(d, meta)
+}
+
+func resourceTrackerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName, consumerARN, err := TrackerAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = FindTrackerAssociationByTrackerNameAndConsumerARN(conn, trackerName, consumerARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Tracker Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Location Service Tracker Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("consumer_arn", consumerARN)
+	d.Set("tracker_name", trackerName)
+
+	return nil
+}
+
+func resourceTrackerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName, consumerARN, err := TrackerAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Location Service Tracker Association: %s", d.Id())
+	_, err = conn.DisassociateTrackerConsumer(&locationservice.DisassociateTrackerConsumerInput{
+		ConsumerArn: aws.String(consumerARN),
+		TrackerName: aws.String(trackerName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Location Service Tracker Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package location
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTrackerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTrackerAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tracker_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func dataSourceTrackerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName := d.Get("tracker_name").(string)
+	consumerARN := d.Get("consumer_arn").(string)
+	id := TrackerAssociationCreateID(trackerName, consumerARN)
+
+	err := FindTrackerAssociationByTrackerNameAndConsumerARN(conn, trackerName, consumerARN)
+
+	if err != nil {
+		return fmt.Errorf("error reading Location Service Tracker Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package location_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLocationTrackerAssociationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_location_tracker_association.test"
+	resourceName := "aws_location_tracker_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(locationservice.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTrackerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrackerAssociationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "consumer_arn", resourceName, "consumer_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tracker_name", resourceName, "tracker_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrackerAssociationDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTrackerAssociationConfig(rName), `
+data "aws_location_tracker_association" "test" {
+  consumer_arn = aws_location_tracker_association.test.consumer_arn
+  tracker_name = aws_location_tracker_association.test.tracker_name
+}
+`)
+}
@@ -0,0 +1,194 @@
+package memorydb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceACLCreate,
+		Read:   resourceACLRead,
+		Update: resourceACLUpdate,
+		Delete: resourceACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &memorydb.CreateACLInput{
+		ACLName: aws.String(name),
+		Tags:    Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("user_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.UserNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB ACL: %s", input)
+	_, err := conn.CreateACL(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB ACL (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitACLActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB ACL (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceACLRead(d, meta)
+}
+
+func resourceACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	acl, err := FindACLByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB ACL (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", acl.ARN)
+	d.Set("minimum_engine_version", acl.MinimumEngineVersion)
+	d.Set("name", acl.Name)
+	d.Set("user_names", flex.FlattenStringSet(acl.UserNames))
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB ACL (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChange("user_names") {
+		o, n := d.GetChange("user_names")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input := &memorydb.UpdateACLInput{
+			ACLName: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.UserNamesToAdd = flex.ExpandStringSet(add)
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input.UserNamesToRemove = flex.ExpandStringSet(del)
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB ACL: %s", input)
+		_, err := conn.UpdateACL(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB ACL (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitACLActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB ACL (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB ACL (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceACLRead(d, meta)
+}
+
+func resourceACLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB ACL: %s", d.Id())
+	_, err := conn.DeleteACL(&memorydb.DeleteACLInput{
+		ACLName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB ACL (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitACLDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB ACL (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
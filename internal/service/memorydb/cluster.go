@@ -0,0 +1,611 @@
+package memorydb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	clusterSNSTopicStatusActive   = "active"
+	clusterSNSTopicStatusInactive = "inactive"
+)
+
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterCreate,
+		Read:   resourceClusterRead,
+		Update: resourceClusterUpdate,
+		Delete: resourceClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"cluster_endpoint": endpointSchema(),
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"engine_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"final_snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateResourceName(snapshotNameMaxLength),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateResourceName(clusterNameMaxLength),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"num_replicas_per_shard": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"shards": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nodes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"create_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"endpoint": endpointSchema(),
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"num_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_arns": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"snapshot_name"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_arns"},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"snapshot_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidOnceADayWindowFormat,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func endpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &memorydb.CreateClusterInput{
+		ACLName:                 aws.String(d.Get("acl_name").(string)),
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		ClusterName:             aws.String(name),
+		Description:             aws.String(d.Get("description").(string)),
+		NodeType:                aws.String(d.Get("node_type").(string)),
+		NumReplicasPerShard:     aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+		NumShards:               aws.Int64(int64(d.Get("num_shards").(int))),
+		Tags:                    Tags(tags.IgnoreAWS()),
+		TLSEnabled:              aws.Bool(d.Get("tls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		input.ParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArns = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_name"); ok {
+		input.SnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("snapshot_window"); ok {
+		input.SnapshotWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		input.SubnetGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Cluster: %s", input)
+	_, err := conn.CreateCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Cluster (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Cluster (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceClusterRead(d, meta)
+}
+
+func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cluster, err := FindClusterByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	d.Set("acl_name", cluster.ACLName)
+	d.Set("arn", cluster.ARN)
+	d.Set("auto_minor_version_upgrade", cluster.AutoMinorVersionUpgrade)
+	if v := cluster.ClusterEndpoint; v != nil {
+		d.Set("cluster_endpoint", flattenEndpoint(v))
+		d.Set("port", v.Port)
+	} else {
+		d.Set("cluster_endpoint", nil)
+	}
+	d.Set("description", cluster.Description)
+	d.Set("engine_patch_version", cluster.EnginePatchVersion)
+	d.Set("engine_version", cluster.EngineVersion)
+	d.Set("kms_key_arn", cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
+	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("name", cluster.Name)
+	d.Set("node_type", cluster.NodeType)
+
+	numReplicasPerShard, err := deriveClusterNumReplicasPerShard(cluster)
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	d.Set("num_replicas_per_shard", numReplicasPerShard)
+	d.Set("num_shards", cluster.NumberOfShards)
+	d.Set("parameter_group_name", cluster.ParameterGroupName)
+
+	var securityGroupIDs []*string
+	for _, v := range cluster.SecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, v.SecurityGroupId)
+	}
+	d.Set("security_group_ids", flex.FlattenStringSet(securityGroupIDs))
+
+	if err := d.Set("shards", flattenShards(cluster.Shards)); err != nil {
+		return fmt.Errorf("error setting shards: %w", err)
+	}
+
+	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
+	d.Set("snapshot_window", cluster.SnapshotWindow)
+
+	if aws.StringValue(cluster.SnsTopicStatus) == clusterSNSTopicStatusActive {
+		d.Set("sns_topic_arn", cluster.SnsTopicArn)
+	} else {
+		d.Set("sns_topic_arn", "")
+	}
+
+	d.Set("subnet_group_name", cluster.SubnetGroupName)
+	d.Set("tls_enabled", cluster.TLSEnabled)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChangesExcept("final_snapshot_name", "tags", "tags_all") {
+		input := &memorydb.UpdateClusterInput{
+			ClusterName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("acl_name") {
+			input.ACLName = aws.String(d.Get("acl_name").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		if d.HasChange("maintenance_window") {
+			input.MaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		}
+
+		if d.HasChange("node_type") {
+			input.NodeType = aws.String(d.Get("node_type").(string))
+		}
+
+		if d.HasChange("num_replicas_per_shard") {
+			input.ReplicaConfiguration = &memorydb.ReplicaConfigurationRequest{
+				ReplicaCount: aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+			}
+		}
+
+		if d.HasChange("num_shards") {
+			input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
+				ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+			}
+		}
+
+		if d.HasChange("parameter_group_name") {
+			input.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		}
+
+		if d.HasChange("security_group_ids") {
+			// The API rejects an empty list of security groups.
+			if v := d.Get("security_group_ids").(*schema.Set); v.Len() > 0 {
+				input.SecurityGroupIds = flex.ExpandStringSet(v)
+			}
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("snapshot_window") {
+			input.SnapshotWindow = aws.String(d.Get("snapshot_window").(string))
+		}
+
+		if d.HasChange("sns_topic_arn") {
+			v := d.Get("sns_topic_arn").(string)
+
+			input.SnsTopicArn = aws.String(v)
+
+			if v == "" {
+				input.SnsTopicStatus = aws.String(clusterSNSTopicStatusInactive)
+			} else {
+				input.SnsTopicStatus = aws.String(clusterSNSTopicStatusActive)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Cluster: %s", input)
+		_, err := conn.UpdateCluster(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB Cluster (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB Cluster (%s) update: %w", d.Id(), err)
+		}
+
+		if d.HasChange("parameter_group_name") {
+			if _, err := waitClusterParameterGroupInSync(conn, d.Id()); err != nil {
+				return fmt.Errorf("error waiting for MemoryDB Cluster (%s) parameter group to be in sync: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Cluster (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceClusterRead(d, meta)
+}
+
+func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	input := &memorydb.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("final_snapshot_name"); ok {
+		input.FinalSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Cluster (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deriveClusterNumReplicasPerShard determines the replicas per shard
+// configuration of a cluster. As this cannot directly be read back, we
+// assume that it's the same as that of the largest shard.
+//
+// For the sake of caution, this search is limited to stable shards.
+func deriveClusterNumReplicasPerShard(cluster *memorydb.Cluster) (int, error) {
+	var maxNumberOfNodesPerShard int64
+
+	for _, shard := range cluster.Shards {
+		if aws.StringValue(shard.Status) != ClusterStatusAvailable {
+			continue
+		}
+
+		if n := aws.Int64Value(shard.NumberOfNodes); n > maxNumberOfNodesPerShard {
+			maxNumberOfNodesPerShard = n
+		}
+	}
+
+	if maxNumberOfNodesPerShard == 0 {
+		return 0, fmt.Errorf("no available shards found")
+	}
+
+	return int(maxNumberOfNodesPerShard - 1), nil
+}
+
+func flattenEndpoint(apiObject *memorydb.Endpoint) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"address": aws.StringValue(apiObject.Address),
+		"port":    aws.Int64Value(apiObject.Port),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenShards(apiObjects []*memorydb.Shard) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var nodes []interface{}
+
+		for _, node := range apiObject.Nodes {
+			if node == nil {
+				continue
+			}
+
+			nodes = append(nodes, map[string]interface{}{
+				"availability_zone": aws.StringValue(node.AvailabilityZone),
+				"create_time":       aws.TimeValue(node.CreateTime).Format(time.RFC3339),
+				"endpoint":          flattenEndpoint(node.Endpoint),
+				"name":              aws.StringValue(node.Name),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":      aws.StringValue(apiObject.Name),
+			"num_nodes": aws.Int64Value(apiObject.NumberOfNodes),
+			"nodes":     nodes,
+			"slots":     aws.StringValue(apiObject.Slots),
+		})
+	}
+
+	return tfList
+}
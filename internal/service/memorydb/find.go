@@ -0,0 +1,221 @@
+package memorydb
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindACLByName(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	input := &memorydb.DescribeACLsInput{
+		ACLName: aws.String(name),
+	}
+
+	output, err := conn.DescribeACLs(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ACLs) == 0 || output.ACLs[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ACLs); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ACLs[0], nil
+}
+
+func FindClusterByName(conn *memorydb.MemoryDB, name string) (*memorydb.Cluster, error) {
+	input := &memorydb.DescribeClustersInput{
+		ClusterName:      aws.String(name),
+		ShowShardDetails: aws.Bool(true),
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Clusters); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Clusters[0], nil
+}
+
+func FindParameterGroupByName(conn *memorydb.MemoryDB, name string) (*memorydb.ParameterGroup, error) {
+	input := &memorydb.DescribeParameterGroupsInput{
+		ParameterGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeParameterGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ParameterGroups) == 0 || output.ParameterGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ParameterGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ParameterGroups[0], nil
+}
+
+func FindSnapshotByName(conn *memorydb.MemoryDB, name string) (*memorydb.Snapshot, error) {
+	input := &memorydb.DescribeSnapshotsInput{
+		SnapshotName: aws.String(name),
+	}
+
+	output, err := conn.DescribeSnapshots(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSnapshotNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Snapshots) == 0 || output.Snapshots[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Snapshots); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Snapshots[0], nil
+}
+
+func FindSubnetGroupByName(conn *memorydb.MemoryDB, name string) (*memorydb.SubnetGroup, error) {
+	input := &memorydb.DescribeSubnetGroupsInput{
+		SubnetGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeSubnetGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSubnetGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.SubnetGroups) == 0 || output.SubnetGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.SubnetGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.SubnetGroups[0], nil
+}
+
+func FindUserByName(conn *memorydb.MemoryDB, name string) (*memorydb.User, error) {
+	input := &memorydb.DescribeUsersInput{
+		UserName: aws.String(name),
+	}
+
+	output, err := conn.DescribeUsers(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeUserNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Users) == 0 || output.Users[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Users); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Users[0], nil
+}
+
+// FindParametersByParameterGroupName returns all parameters, including defaults, in the named parameter group.
+func FindParametersByParameterGroupName(conn *memorydb.MemoryDB, name string) ([]*memorydb.Parameter, error) {
+	input := &memorydb.DescribeParametersInput{
+		ParameterGroupName: aws.String(name),
+	}
+	var parameters []*memorydb.Parameter
+
+	for {
+		output, err := conn.DescribeParameters(input)
+
+		if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		parameters = append(parameters, output.Parameters...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return parameters, nil
+}
@@ -0,0 +1,358 @@
+package memorydb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceParameterGroupCreate,
+		Read:   resourceParameterGroupRead,
+		Update: resourceParameterGroupUpdate,
+		Delete: resourceParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateResourceName(parameterGroupNameMaxLength),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               Tags(tags.IgnoreAWS()),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	_, err := conn.CreateParameterGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Parameter Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	// Parameters can only be set once the group exists.
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		if err := modifyParameterGroupParameters(conn, d.Id(), expandParameterNameValues(v.(*schema.Set).List())); err != nil {
+			return err
+		}
+	}
+
+	return resourceParameterGroupRead(d, meta)
+}
+
+func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	group, err := FindParameterGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+
+	parameters, err := FindParametersByParameterGroupName(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err)
+	}
+
+	// The API does not distinguish user-defined parameters from defaults,
+	// so only report the parameters that are present in configuration.
+	configured := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("parameter").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			configured[strings.ToLower(tfMap["name"].(string))] = struct{}{}
+		}
+	}
+
+	var userParameters []*memorydb.Parameter
+
+	for _, parameter := range parameters {
+		if _, ok := configured[strings.ToLower(aws.StringValue(parameter.Name))]; ok {
+			userParameters = append(userParameters, parameter)
+		}
+	}
+
+	if err := d.Set("parameter", flattenParameters(userParameters)); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		toRemove, toAdd := parameterChanges(o, n)
+
+		if len(toRemove) > 0 {
+			if err := resetParameterGroupParameters(conn, d.Id(), toRemove); err != nil {
+				return err
+			}
+		}
+
+		if len(toAdd) > 0 {
+			if err := modifyParameterGroupParameters(conn, d.Id(), toAdd); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Parameter Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceParameterGroupRead(d, meta)
+}
+
+func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group: %s", d.Id())
+	_, err := conn.DeleteParameterGroup(&memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// parameterGroupMaxParamsBulkEdit is the maximum number of parameters that
+// can be modified or reset in a single request.
+const parameterGroupMaxParamsBulkEdit = 20
+
+func modifyParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	for len(parameters) > 0 {
+		var batch []*memorydb.ParameterNameValue
+
+		if len(parameters) <= parameterGroupMaxParamsBulkEdit {
+			batch, parameters = parameters, nil
+		} else {
+			batch, parameters = parameters[:parameterGroupMaxParamsBulkEdit], parameters[parameterGroupMaxParamsBulkEdit:]
+		}
+
+		input := &memorydb.UpdateParameterGroupInput{
+			ParameterGroupName:  aws.String(name),
+			ParameterNameValues: batch,
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Parameter Group: %s", input)
+		_, err := conn.UpdateParameterGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func resetParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	for len(parameters) > 0 {
+		var batch []*memorydb.ParameterNameValue
+
+		if len(parameters) <= parameterGroupMaxParamsBulkEdit {
+			batch, parameters = parameters, nil
+		} else {
+			batch, parameters = parameters[:parameterGroupMaxParamsBulkEdit], parameters[parameterGroupMaxParamsBulkEdit:]
+		}
+
+		var parameterNames []*string
+
+		for _, parameter := range batch {
+			parameterNames = append(parameterNames, parameter.ParameterName)
+		}
+
+		input := &memorydb.ResetParameterGroupInput{
+			ParameterGroupName: aws.String(name),
+			ParameterNames:     parameterNames,
+		}
+
+		log.Printf("[DEBUG] Resetting MemoryDB Parameter Group: %s", input)
+		_, err := conn.ResetParameterGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error resetting MemoryDB Parameter Group (%s) parameters: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// parameterChanges returns the parameters to reset, i.e. those only present in
+// the old set, and the parameters to add or update.
+func parameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.ParameterNameValue) {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	om := make(map[string]*memorydb.ParameterNameValue)
+	for _, parameter := range expandParameterNameValues(o.(*schema.Set).List()) {
+		om[aws.StringValue(parameter.ParameterName)] = parameter
+	}
+
+	nm := make(map[string]*memorydb.ParameterNameValue)
+	for _, parameter := range expandParameterNameValues(n.(*schema.Set).List()) {
+		nm[aws.StringValue(parameter.ParameterName)] = parameter
+	}
+
+	for k, ov := range om {
+		if _, ok := nm[k]; !ok {
+			remove = append(remove, ov)
+		}
+	}
+
+	for k, nv := range nm {
+		if ov, ok := om[k]; !ok || aws.StringValue(ov.ParameterValue) != aws.StringValue(nv.ParameterValue) {
+			addOrUpdate = append(addOrUpdate, nv)
+		}
+	}
+
+	return remove, addOrUpdate
+}
+
+func expandParameterNameValues(tfList []interface{}) []*memorydb.ParameterNameValue {
+	var apiObjects []*memorydb.ParameterNameValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &memorydb.ParameterNameValue{
+			ParameterName:  aws.String(tfMap["name"].(string)),
+			ParameterValue: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenParameters(apiObjects []*memorydb.Parameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  strings.ToLower(aws.StringValue(apiObject.Name)),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
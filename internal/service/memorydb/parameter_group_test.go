@@ -0,0 +1,308 @@
+package memorydb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmemorydb "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMemoryDBParameterGroup_basic(t *testing.T) {
+	var v memorydb.ParameterGroup
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "parametergroup/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "family", "memorydb_redis6"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-max",
+						"value": "70",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameter"},
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_disappears(t *testing.T) {
+	var v memorydb.ParameterGroup
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmemorydb.ResourceParameterGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_parameters(t *testing.T) {
+	var v memorydb.ParameterGroup
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfigParameters(rName, "70", "30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-max",
+						"value": "70",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-min",
+						"value": "30",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Import cannot distinguish configured parameters from defaults.
+				ImportStateVerifyIgnore: []string{"parameter"},
+			},
+			{
+				Config: testAccParameterGroupConfigParameters(rName, "75", "25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-max",
+						"value": "75",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-min",
+						"value": "25",
+					}),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfigNoParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_tags(t *testing.T) {
+	var v memorydb.ParameterGroup
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(memorydb.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, memorydb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameter"},
+			},
+			{
+				Config: testAccParameterGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParameterGroupExists(n string, v *memorydb.ParameterGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Parameter Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		output, err := tfmemorydb.FindParameterGroupByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckParameterGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_parameter_group" {
+			continue
+		}
+
+		_, err := tfmemorydb.FindParameterGroupByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB Parameter Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccParameterGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = "active-defrag-cycle-max"
+    value = "70"
+  }
+}
+`, rName)
+}
+
+func testAccParameterGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = "active-defrag-cycle-max"
+    value = "70"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccParameterGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = "active-defrag-cycle-max"
+    value = "70"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccParameterGroupConfigParameters(rName, max, min string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = "active-defrag-cycle-max"
+    value = %[2]q
+  }
+
+  parameter {
+    name  = "active-defrag-cycle-min"
+    value = %[3]q
+  }
+}
+`, rName, max, min)
+}
+
+func testAccParameterGroupConfigNoParameters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+}
+`, rName)
+}
@@ -0,0 +1,267 @@
+package memorydb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSnapshotCreate,
+		Read:   resourceSnapshotRead,
+		Update: resourceSnapshotUpdate,
+		Delete: resourceSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"num_shards": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"parameter_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"snapshot_retention_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"snapshot_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateResourceName(snapshotNameMaxLength),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &memorydb.CreateSnapshotInput{
+		ClusterName:  aws.String(d.Get("cluster_name").(string)),
+		SnapshotName: aws.String(name),
+		Tags:         Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Snapshot: %s", input)
+	_, err := conn.CreateSnapshot(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Snapshot (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitSnapshotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Snapshot (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceSnapshotRead(d, meta)
+}
+
+func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	snapshot, err := FindSnapshotByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Snapshot (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", snapshot.ARN)
+	if err := d.Set("cluster_configuration", flattenClusterConfiguration(snapshot.ClusterConfiguration)); err != nil {
+		return fmt.Errorf("error setting cluster_configuration: %w", err)
+	}
+	if v := snapshot.ClusterConfiguration; v != nil {
+		d.Set("cluster_name", v.Name)
+	}
+	d.Set("kms_key_arn", snapshot.KmsKeyId)
+	d.Set("name", snapshot.Name)
+	d.Set("source", snapshot.Source)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Snapshot (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Snapshot (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSnapshotRead(d, meta)
+}
+
+func resourceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Snapshot: %s", d.Id())
+	_, err := conn.DeleteSnapshot(&memorydb.DeleteSnapshotInput{
+		SnapshotName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSnapshotNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Snapshot (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitSnapshotDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Snapshot (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenClusterConfiguration(apiObject *memorydb.ClusterConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"description":              aws.StringValue(apiObject.Description),
+		"engine_version":           aws.StringValue(apiObject.EngineVersion),
+		"maintenance_window":       aws.StringValue(apiObject.MaintenanceWindow),
+		"name":                     aws.StringValue(apiObject.Name),
+		"node_type":                aws.StringValue(apiObject.NodeType),
+		"num_shards":               aws.Int64Value(apiObject.NumShards),
+		"parameter_group_name":     aws.StringValue(apiObject.ParameterGroupName),
+		"port":                     aws.Int64Value(apiObject.Port),
+		"snapshot_retention_limit": aws.Int64Value(apiObject.SnapshotRetentionLimit),
+		"snapshot_window":          aws.StringValue(apiObject.SnapshotWindow),
+		"subnet_group_name":        aws.StringValue(apiObject.SubnetGroupName),
+		"topic_arn":                aws.StringValue(apiObject.TopicArn),
+		"vpc_id":                   aws.StringValue(apiObject.VpcId),
+	}
+
+	return []interface{}{tfMap}
+}
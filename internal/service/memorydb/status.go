@@ -0,0 +1,113 @@
+package memorydb
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	ACLStatusActive    = "active"
+	ACLStatusCreating  = "creating"
+	ACLStatusDeleting  = "deleting"
+	ACLStatusModifying = "modifying"
+
+	ClusterStatusAvailable    = "available"
+	ClusterStatusCreating     = "creating"
+	ClusterStatusDeleting     = "deleting"
+	ClusterStatusSnapshotting = "snapshotting"
+	ClusterStatusUpdating     = "updating"
+
+	ClusterParameterGroupStatusApplying = "applying"
+	ClusterParameterGroupStatusInSync   = "in-sync"
+
+	SnapshotStatusAvailable = "available"
+	SnapshotStatusCopying   = "copying"
+	SnapshotStatusCreating  = "creating"
+	SnapshotStatusDeleting  = "deleting"
+
+	UserStatusActive    = "active"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+)
+
+func statusACL(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindACLByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusCluster(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusClusterParameterGroup(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ParameterGroupStatus), nil
+	}
+}
+
+func statusSnapshot(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSnapshotByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusUser(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindUserByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,182 @@
+package memorydb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSubnetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSubnetGroupCreate,
+		Read:   resourceSubnetGroupRead,
+		Update: resourceSubnetGroupUpdate,
+		Delete: resourceSubnetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateResourceName(subnetGroupNameMaxLength),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &memorydb.CreateSubnetGroupInput{
+		Description:     aws.String(d.Get("description").(string)),
+		SubnetGroupName: aws.String(name),
+		SubnetIds:       flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		Tags:            Tags(tags.IgnoreAWS()),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Subnet Group: %s", input)
+	_, err := conn.CreateSubnetGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Subnet Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceSubnetGroupRead(d, meta)
+}
+
+func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	group, err := FindSubnetGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Subnet Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	var subnetIDs []*string
+
+	for _, subnet := range group.Subnets {
+		subnetIDs = append(subnetIDs, subnet.Identifier)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("name", group.Name)
+	d.Set("subnet_ids", flex.FlattenStringSet(subnetIDs))
+	d.Set("vpc_id", group.VpcId)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChanges("description", "subnet_ids") {
+		input := &memorydb.UpdateSubnetGroupInput{
+			Description:     aws.String(d.Get("description").(string)),
+			SubnetGroupName: aws.String(d.Id()),
+			SubnetIds:       flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Subnet Group: %s", input)
+		_, err := conn.UpdateSubnetGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB Subnet Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Subnet Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSubnetGroupRead(d, meta)
+}
+
+func resourceSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Subnet Group: %s", d.Id())
+	_, err := conn.DeleteSubnetGroup(&memorydb.DeleteSubnetGroupInput{
+		SubnetGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSubnetGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,365 @@
+//go:build sweep
+// +build sweep
+
+package memorydb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+		Name: "aws_memorydb_acl",
+		F:    sweepACLs,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+		Name: "aws_memorydb_cluster",
+		F:    sweepClusters,
+	})
+
+	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+		Name: "aws_memorydb_parameter_group",
+		F:    sweepParameterGroups,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+		Name: "aws_memorydb_snapshot",
+		F:    sweepSnapshots,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+		Name: "aws_memorydb_subnet_group",
+		F:    sweepSubnetGroups,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+		Name: "aws_memorydb_user",
+		F:    sweepUsers,
+		Dependencies: []string{
+			"aws_memorydb_acl",
+		},
+	})
+}
+
+func sweepACLs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	input := &memorydb.DescribeACLsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.DescribeACLs(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MemoryDB ACL sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MemoryDB ACLs (%s): %w", region, err)
+		}
+
+		for _, v := range output.ACLs {
+			name := aws.StringValue(v.Name)
+
+			if name == "open-access" {
+				log.Printf("[INFO] Skipping MemoryDB ACL: %s", name)
+				continue
+			}
+
+			r := ResourceACL()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB ACLs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	input := &memorydb.DescribeClustersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.DescribeClusters(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MemoryDB Cluster sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MemoryDB Clusters (%s): %w", region, err)
+		}
+
+		for _, v := range output.Clusters {
+			name := aws.StringValue(v.Name)
+
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepParameterGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	input := &memorydb.DescribeParameterGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.DescribeParameterGroups(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MemoryDB Parameter Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MemoryDB Parameter Groups (%s): %w", region, err)
+		}
+
+		for _, v := range output.ParameterGroups {
+			name := aws.StringValue(v.Name)
+
+			if strings.HasPrefix(name, "default") {
+				log.Printf("[INFO] Skipping MemoryDB Parameter Group: %s", name)
+				continue
+			}
+
+			r := ResourceParameterGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB Parameter Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSnapshots(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	input := &memorydb.DescribeSnapshotsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.DescribeSnapshots(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MemoryDB Snapshot sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MemoryDB Snapshots (%s): %w", region, err)
+		}
+
+		for _, v := range output.Snapshots {
+			name := aws.StringValue(v.Name)
+
+			r := ResourceSnapshot()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB Snapshots (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSubnetGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	input := &memorydb.DescribeSubnetGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.DescribeSubnetGroups(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MemoryDB Subnet Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MemoryDB Subnet Groups (%s): %w", region, err)
+		}
+
+		for _, v := range output.SubnetGroups {
+			name := aws.StringValue(v.Name)
+
+			if name == "default" {
+				log.Printf("[INFO] Skipping MemoryDB Subnet Group: %s", name)
+				continue
+			}
+
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB Subnet Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepUsers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	input := &memorydb.DescribeUsersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	for {
+		output, err := conn.DescribeUsers(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MemoryDB User sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MemoryDB Users (%s): %w", region, err)
+		}
+
+		for _, v := range output.Users {
+			name := aws.StringValue(v.Name)
+
+			if name == "default" {
+				log.Printf("[INFO] Skipping MemoryDB User: %s", name)
+				continue
+			}
+
+			r := ResourceUser()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB Users (%s): %w", region, err)
+	}
+
+	return nil
+}
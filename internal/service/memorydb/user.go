@@ -0,0 +1,255 @@
+package memorydb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserCreate,
+		Read:   resourceUserRead,
+		Update: resourceUserUpdate,
+		Delete: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_string": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_mode": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"passwords": {
+							Type:      schema.TypeSet,
+							Required:  true,
+							MinItems:  1,
+							MaxItems:  2,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(memorydb.InputAuthenticationType_Values(), false),
+						},
+					},
+				},
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	userName := d.Get("user_name").(string)
+	input := &memorydb.CreateUserInput{
+		AccessString: aws.String(d.Get("access_string").(string)),
+		Tags:         Tags(tags.IgnoreAWS()),
+		UserName:     aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB User: %s", userName)
+	_, err := conn.CreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB User (%s): %w", userName, err)
+	}
+
+	d.SetId(userName)
+
+	if _, err := waitUserActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB User (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceUserRead(d, meta)
+}
+
+func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	user, err := FindUserByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB User (%s): %w", d.Id(), err)
+	}
+
+	d.Set("access_string", user.AccessString)
+	d.Set("arn", user.ARN)
+
+	// Passwords are never returned by the API, so keep the configured values.
+	if v := user.Authentication; v != nil {
+		tfMap := map[string]interface{}{
+			"password_count": aws.Int64Value(v.PasswordCount),
+			"passwords":      d.Get("authentication_mode.0.passwords"),
+			"type":           aws.StringValue(v.Type),
+		}
+
+		if err := d.Set("authentication_mode", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting authentication_mode: %w", err)
+		}
+	} else {
+		d.Set("authentication_mode", nil)
+	}
+
+	d.Set("minimum_engine_version", user.MinimumEngineVersion)
+	d.Set("user_name", user.Name)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB User (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &memorydb.UpdateUserInput{
+			UserName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_string") {
+			input.AccessString = aws.String(d.Get("access_string").(string))
+		}
+
+		if d.HasChange("authentication_mode") {
+			if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB User: %s", d.Id())
+		_, err := conn.UpdateUser(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB User (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitUserActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB User (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB User (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(d, meta)
+}
+
+func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB User: %s", d.Id())
+	_, err := conn.DeleteUser(&memorydb.DeleteUserInput{
+		UserName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeUserNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB User (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitUserDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB User (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAuthenticationMode(tfMap map[string]interface{}) *memorydb.AuthenticationMode {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &memorydb.AuthenticationMode{}
+
+	if v, ok := tfMap["passwords"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Passwords = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,28 @@
+package memorydb
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	clusterNameMaxLength        = 40
+	parameterGroupNameMaxLength = 255
+	snapshotNameMaxLength       = 255
+	subnetGroupNameMaxLength    = 255
+)
+
+// validateResourceName validates the names shared by MemoryDB clusters,
+// parameter groups, snapshots and subnet groups.
+func validateResourceName(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validation.StringDoesNotMatch(regexp.MustCompile(`[-][-]`), "The name may not contain two consecutive hyphens."),
+		validation.StringMatch(
+			// Similar to ElastiCache, MemoryDB normalises names to lowercase.
+			regexp.MustCompile(`^[a-z0-9-]*[a-z0-9]$`),
+			"Only lowercase alphanumeric characters and hyphens allowed. The name may not end with a hyphen."),
+	)
+}
@@ -0,0 +1,56 @@
+package memorydb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateResourceName(t *testing.T) {
+	validator := validateResourceName(clusterNameMaxLength)
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "valid-name-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 2,
+		},
+		{
+			Value:    "Uppercase",
+			ErrCount: 1,
+		},
+		{
+			Value:    "double--hyphen",
+			ErrCount: 1,
+		},
+		{
+			Value:    "trailing-hyphen-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "under_score",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", clusterNameMaxLength+1),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validator(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Errorf("validateResourceName(%q) returned %d errors, expected %d", tc.Value, len(errors), tc.ErrCount)
+		}
+	}
+}
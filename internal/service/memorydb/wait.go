@@ -0,0 +1,176 @@
+package memorydb
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	aclActiveTimeout  = 5 * time.Minute
+	aclDeletedTimeout = 5 * time.Minute
+
+	clusterParameterGroupInSyncTimeout = 60 * time.Minute
+
+	userActiveTimeout  = 5 * time.Minute
+	userDeletedTimeout = 5 * time.Minute
+)
+
+func waitACLActive(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusCreating, ACLStatusModifying},
+		Target:  []string{ACLStatusActive},
+		Refresh: statusACL(conn, name),
+		Timeout: aclActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.ACL); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitACLDeleted(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusDeleting},
+		Target:  []string{},
+		Refresh: statusACL(conn, name),
+		Timeout: aclDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.ACL); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterAvailable(conn *memorydb.MemoryDB, name string, timeout time.Duration) (*memorydb.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ClusterStatusCreating, ClusterStatusSnapshotting, ClusterStatusUpdating},
+		Target:     []string{ClusterStatusAvailable},
+		Refresh:    statusCluster(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(conn *memorydb.MemoryDB, name string, timeout time.Duration) (*memorydb.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ClusterStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCluster(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitClusterParameterGroupInSync waits for a parameter group change to be applied to every node in the cluster.
+func waitClusterParameterGroupInSync(conn *memorydb.MemoryDB, name string) (*memorydb.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterParameterGroupStatusApplying},
+		Target:  []string{ClusterParameterGroupStatusInSync},
+		Refresh: statusClusterParameterGroup(conn, name),
+		Timeout: clusterParameterGroupInSyncTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSnapshotAvailable(conn *memorydb.MemoryDB, name string, timeout time.Duration) (*memorydb.Snapshot, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SnapshotStatusCopying, SnapshotStatusCreating},
+		Target:  []string{SnapshotStatusAvailable},
+		Refresh: statusSnapshot(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Snapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSnapshotDeleted(conn *memorydb.MemoryDB, name string, timeout time.Duration) (*memorydb.Snapshot, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SnapshotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSnapshot(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Snapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitUserActive(conn *memorydb.MemoryDB, name string) (*memorydb.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusModifying},
+		Target:  []string{UserStatusActive},
+		Refresh: statusUser(conn, name),
+		Timeout: userActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.User); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitUserDeleted(conn *memorydb.MemoryDB, name string) (*memorydb.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusDeleting},
+		Target:  []string{},
+		Refresh: statusUser(conn, name),
+		Timeout: userDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.User); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -58,3 +58,18 @@ func ExportableLogType_Values() []string {
 		ExportableLogTypeUpgrade,
 	}
 }
+
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
+const (
+	InstanceAutomatedBackupStatusPending     = "pending"
+	InstanceAutomatedBackupStatusReplicating = "replicating"
+	InstanceAutomatedBackupStatusRetained    = "retained"
+)
@@ -0,0 +1,228 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+)
+
+func TestAccRDSExportTask_basic(t *testing.T) {
+	var exportTask rds.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+	snapshotResourceName := "aws_db_snapshot.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	roleResourceName := "aws_iam_role.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExportTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "export_task_identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", keyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "percent_progress", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_name", bucketResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", snapshotResourceName, "db_snapshot_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.ExportTaskStatusComplete),
+					resource.TestCheckResourceAttrSet(resourceName, "task_end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_start_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSExportTask_exportOnly(t *testing.T) {
+	var exportTask rds.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExportTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskExportOnlyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.0", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.ExportTaskStatusComplete),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExportTaskExists(resourceName string, v *rds.ExportTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Export Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindExportTaskByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// Export tasks cannot be deleted once they have completed, so only check
+// that no task is left running.
+func testAccCheckExportTaskDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_export_task" {
+			continue
+		}
+
+		output, err := tfrds.FindExportTaskByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			continue
+		}
+
+		if status := *output.Status; status == tfrds.ExportTaskStatusStarting || status == tfrds.ExportTaskStatusInProgress {
+			return fmt.Errorf("RDS Export Task %s still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccExportTaskBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "export.rds.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:PutObject*",
+        "s3:ListBucket",
+        "s3:GetObject*",
+        "s3:DeleteObject*",
+        "s3:GetBucketLocation",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  engine                  = "mysql"
+  instance_class          = "db.t3.micro"
+  name                    = "test"
+  username                = "foo"
+  password                = "foobarbaz"
+  skip_final_snapshot     = true
+  backup_retention_period = 0
+}
+
+resource "aws_db_snapshot" "test" {
+  db_instance_identifier = aws_db_instance.test.id
+  db_snapshot_identifier = %[1]q
+}
+`, rName)
+}
+
+func testAccExportTaskConfig(rName string) string {
+	return acctest.ConfigCompose(testAccExportTaskBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccExportTaskExportOnlyConfig(rName string) string {
+	return acctest.ConfigCompose(testAccExportTaskBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  s3_prefix              = "exports"
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+  export_only            = ["mysql"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
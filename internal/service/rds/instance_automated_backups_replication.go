@@ -0,0 +1,149 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceAutomatedBackupsReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceAutomatedBackupsReplicationCreate,
+		Read:   resourceInstanceAutomatedBackupsReplicationRead,
+		Delete: resourceInstanceAutomatedBackupsReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(75 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"pre_signed_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  7,
+			},
+			"source_db_instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceInstanceAutomatedBackupsReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	sourceDBInstanceARN := d.Get("source_db_instance_arn").(string)
+	input := &rds.StartDBInstanceAutomatedBackupsReplicationInput{
+		BackupRetentionPeriod: aws.Int64(int64(d.Get("retention_period").(int))),
+		SourceDBInstanceArn:   aws.String(sourceDBInstanceARN),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pre_signed_url"); ok {
+		input.PreSignedUrl = aws.String(v.(string))
+	} else {
+		// With SourceRegion set the SDK generates the pre-signed URL.
+		parsedARN, err := arn.Parse(sourceDBInstanceARN)
+
+		if err != nil {
+			return fmt.Errorf("error parsing RDS DB Instance ARN (%s): %w", sourceDBInstanceARN, err)
+		}
+
+		input.SourceRegion = aws.String(parsedARN.Region)
+	}
+
+	log.Printf("[DEBUG] Starting RDS DB Instance Automated Backups Replication: %s", input)
+	output, err := conn.StartDBInstanceAutomatedBackupsReplication(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting RDS DB Instance (%s) Automated Backups Replication: %w", sourceDBInstanceARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.DBInstanceAutomatedBackup.DBInstanceAutomatedBackupsArn))
+
+	if _, err := waitDBInstanceAutomatedBackupCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Automated Backups Replication (%s) to start: %w", d.Id(), err)
+	}
+
+	return resourceInstanceAutomatedBackupsReplicationRead(d, meta)
+}
+
+func resourceInstanceAutomatedBackupsReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance Automated Backups Replication (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance Automated Backups Replication (%s): %w", d.Id(), err)
+	}
+
+	d.Set("kms_key_id", backup.KmsKeyId)
+	d.Set("retention_period", backup.BackupRetentionPeriod)
+	d.Set("source_db_instance_arn", backup.DBInstanceArn)
+
+	return nil
+}
+
+func resourceInstanceAutomatedBackupsReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	sourceDBInstanceARN := d.Get("source_db_instance_arn").(string)
+
+	log.Printf("[DEBUG] Stopping RDS DB Instance Automated Backups Replication: %s", d.Id())
+	_, err := conn.StopDBInstanceAutomatedBackupsReplication(&rds.StopDBInstanceAutomatedBackupsReplicationInput{
+		SourceDBInstanceArn: aws.String(sourceDBInstanceARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceAutomatedBackupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS DB Instance (%s) Automated Backups Replication (%s): %w", sourceDBInstanceARN, d.Id(), err)
+	}
+
+	if _, err := waitDBInstanceAutomatedBackupDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Automated Backups Replication (%s) to stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSInstanceAutomatedBackupsReplication_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var backup rds.DBInstanceAutomatedBackup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+	instanceResourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName, &backup),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "7"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_instance_arn", instanceResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_signed_url"},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_retentionPeriod(t *testing.T) {
+	var providers []*schema.Provider
+	var backup rds.DBInstanceAutomatedBackup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationRetentionPeriodConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName, &backup),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_kmsEncrypted(t *testing.T) {
+	var providers []*schema.Provider
+	var backup rds.DBInstanceAutomatedBackup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationKMSEncryptedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName, &backup),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName string, v *rds.DBInstanceAutomatedBackup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS DB Instance Automated Backups Replication ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindDBInstanceAutomatedBackupByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceAutomatedBackupsReplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance_automated_backups_replication" {
+			continue
+		}
+
+		_, err := tfrds.FindDBInstanceAutomatedBackupByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS DB Instance Automated Backups Replication %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInstanceAutomatedBackupsReplicationBaseConfig(rName string, storageEncrypted bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  provider = "awsalternate"
+
+  identifier              = %[1]q
+  allocated_storage       = 10
+  engine                  = "postgres"
+  instance_class          = "db.t3.micro"
+  name                    = "mydb"
+  username                = "masterusername"
+  password                = "mustbeeightcharacters"
+  backup_retention_period = 7
+  storage_encrypted       = %[2]t
+  skip_final_snapshot     = true
+}
+`, rName, storageEncrypted))
+}
+
+func testAccInstanceAutomatedBackupsReplicationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceAutomatedBackupsReplicationBaseConfig(rName, false), `
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+}
+`)
+}
+
+func testAccInstanceAutomatedBackupsReplicationRetentionPeriodConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceAutomatedBackupsReplicationBaseConfig(rName, false), `
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+  retention_period       = 14
+}
+`)
+}
+
+func testAccInstanceAutomatedBackupsReplicationKMSEncryptedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceAutomatedBackupsReplicationBaseConfig(rName, true), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = %[1]q
+}
+
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+}
+`, rName))
+}
@@ -1,10 +1,13 @@
 package rds
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -198,3 +201,82 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitExportTaskCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		if status := aws.StringValue(output.Status); status == ExportTaskStatusFailed || status == ExportTaskStatusCanceled {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress, ExportTaskStatusCanceling},
+		Target:     []string{ExportTaskStatusCanceled, ExportTaskStatusComplete, ExportTaskStatusFailed},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceAutomatedBackupCreated(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBInstanceAutomatedBackup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InstanceAutomatedBackupStatusPending},
+		Target:     []string{InstanceAutomatedBackupStatusReplicating},
+		Refresh:    statusDBInstanceAutomatedBackup(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceAutomatedBackupDeleted(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBInstanceAutomatedBackup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InstanceAutomatedBackupStatusPending, InstanceAutomatedBackupStatusReplicating},
+		Target:     []string{},
+		Refresh:    statusDBInstanceAutomatedBackup(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -13,3 +13,11 @@ const (
 	ServeSignatureNotSigning      = "NOT_SIGNING"
 	ServeSignatureSigning         = "SIGNING"
 )
+
+const (
+	TrafficPolicyInstanceStateApplied  = "Applied"
+	TrafficPolicyInstanceStateCreating = "Creating"
+	TrafficPolicyInstanceStateDeleting = "Deleting"
+	TrafficPolicyInstanceStateFailed   = "Failed"
+	TrafficPolicyInstanceStateUpdating = "Updating"
+)
@@ -0,0 +1,187 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrafficPolicyCreate,
+		Read:   resourceTrafficPolicyRead,
+		Update: resourceTrafficPolicyUpdate,
+		Delete: resourceTrafficPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"document": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 102400),
+					validation.StringIsJSON,
+				),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		// Updating the document creates a new traffic policy version.
+		CustomizeDiff: customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) bool {
+			return diff.HasChange("document")
+		}),
+	}
+}
+
+func resourceTrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	name := d.Get("name").(string)
+	input := &route53.CreateTrafficPolicyInput{
+		Document: aws.String(d.Get("document").(string)),
+		Name:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route53 Traffic Policy: %s", input)
+	output, err := conn.CreateTrafficPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route53 Traffic Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicy.Id))
+
+	return resourceTrafficPolicyRead(d, meta)
+}
+
+func resourceTrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	trafficPolicy, err := FindTrafficPolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Traffic Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route53 Traffic Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("comment", trafficPolicy.Comment)
+	d.Set("document", trafficPolicy.Document)
+	d.Set("name", trafficPolicy.Name)
+	d.Set("type", trafficPolicy.Type)
+	d.Set("version", trafficPolicy.Version)
+
+	return nil
+}
+
+func resourceTrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	// A new document creates a new version of the traffic policy.
+	// The comment of the latest version can be changed in place.
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(d.Get("document").(string)),
+			Id:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route53 Traffic Policy version: %s", input)
+		_, err := conn.CreateTrafficPolicyVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating Route53 Traffic Policy (%s) version: %w", d.Id(), err)
+		}
+	} else if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Route53 Traffic Policy comment: %s", input)
+		_, err := conn.UpdateTrafficPolicyComment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Route53 Traffic Policy (%s) comment: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTrafficPolicyRead(d, meta)
+}
+
+func resourceTrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	trafficPolicies, err := FindTrafficPolicyVersions(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route53 Traffic Policy (%s) versions: %w", d.Id(), err)
+	}
+
+	for _, v := range trafficPolicies {
+		version := aws.Int64Value(v.Version)
+
+		log.Printf("[INFO] Deleting Route53 Traffic Policy (%s) version: %d", d.Id(), version)
+		_, err := conn.DeleteTrafficPolicy(&route53.DeleteTrafficPolicyInput{
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(version),
+		})
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchTrafficPolicy) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Route53 Traffic Policy (%s) version (%d): %w", d.Id(), version, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTrafficPolicyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrafficPolicyInstanceCreate,
+		Read:   resourceTrafficPolicyInstanceRead,
+		Update: resourceTrafficPolicyInstanceUpdate,
+		Delete: resourceTrafficPolicyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hosted_zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 32),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				StateFunc:    TrimTrailingPeriod,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"traffic_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"traffic_policy_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtMost(2147483647),
+			},
+		},
+	}
+}
+
+func resourceTrafficPolicyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	name := d.Get("name").(string)
+	input := &route53.CreateTrafficPolicyInstanceInput{
+		HostedZoneId:         aws.String(d.Get("hosted_zone_id").(string)),
+		Name:                 aws.String(name),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Route53 Traffic Policy Instance: %s", input)
+	output, err := conn.CreateTrafficPolicyInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route53 Traffic Policy Instance (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicyInstance.Id))
+
+	if _, err := waitTrafficPolicyInstanceStateCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Route53 Traffic Policy Instance (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceTrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceTrafficPolicyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	trafficPolicyInstance, err := FindTrafficPolicyInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Traffic Policy Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route53 Traffic Policy Instance (%s): %w", d.Id(), err)
+	}
+
+	d.Set("hosted_zone_id", trafficPolicyInstance.HostedZoneId)
+	d.Set("name", TrimTrailingPeriod(aws.StringValue(trafficPolicyInstance.Name)))
+	d.Set("traffic_policy_id", trafficPolicyInstance.TrafficPolicyId)
+	d.Set("traffic_policy_version", trafficPolicyInstance.TrafficPolicyVersion)
+	d.Set("ttl", trafficPolicyInstance.TTL)
+
+	return nil
+}
+
+func resourceTrafficPolicyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	input := &route53.UpdateTrafficPolicyInstanceInput{
+		Id:                   aws.String(d.Id()),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating Route53 Traffic Policy Instance: %s", input)
+	_, err := conn.UpdateTrafficPolicyInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route53 Traffic Policy Instance (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitTrafficPolicyInstanceStateUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Route53 Traffic Policy Instance (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceTrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceTrafficPolicyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	log.Printf("[INFO] Deleting Route53 Traffic Policy Instance: %s", d.Id())
+	_, err := conn.DeleteTrafficPolicyInstance(&route53.DeleteTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchTrafficPolicyInstance) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route53 Traffic Policy Instance (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitTrafficPolicyInstanceStateDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Route53 Traffic Policy Instance (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
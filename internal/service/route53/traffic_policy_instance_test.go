@@ -0,0 +1,168 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53TrafficPolicyInstance_basic(t *testing.T) {
+	var v route53.TrafficPolicyInstance
+	resourceName := "aws_route53_traffic_policy_instance.test"
+	zoneResourceName := "aws_route53_zone.test"
+	policyResourceName := "aws_route53_traffic_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyInstanceConfig(rName, zoneName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", zoneResourceName, "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s.%s", rName, zoneName)),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", policyResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_version", policyResourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53TrafficPolicyInstance_disappears(t *testing.T) {
+	var v route53.TrafficPolicyInstance
+	resourceName := "aws_route53_traffic_policy_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyInstanceConfig(rName, zoneName, 360),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53.ResourceTrafficPolicyInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53TrafficPolicyInstance_update(t *testing.T) {
+	var v route53.TrafficPolicyInstance
+	resourceName := "aws_route53_traffic_policy_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyInstanceConfig(rName, zoneName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccTrafficPolicyInstanceConfig(rName, zoneName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceExists(n string, v *route53.TrafficPolicyInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Traffic Policy Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		output, err := tfroute53.FindTrafficPolicyInstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy_instance" {
+			continue
+		}
+
+		_, err := tfroute53.FindTrafficPolicyInstanceByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route53 Traffic Policy Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTrafficPolicyInstanceConfig(rName, zoneName string, ttl int) string {
+	return acctest.ConfigCompose(testAccTrafficPolicyConfig(rName, "10.0.0.1"), fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_traffic_policy_instance" "test" {
+  hosted_zone_id         = aws_route53_zone.test.zone_id
+  name                   = "%[1]s.%[2]s"
+  traffic_policy_id      = aws_route53_traffic_policy.test.id
+  traffic_policy_version = aws_route53_traffic_policy.test.version
+  ttl                    = %[3]d
+}
+`, rName, zoneName, ttl))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -107,3 +108,62 @@ func waitKeySigningKeyStatusUpdated(conn *route53.Route53, hostedZoneID string,
 
 	return nil, err
 }
+
+func waitTrafficPolicyInstanceStateCreated(conn *route53.Route53, id string, timeout time.Duration) (*route53.TrafficPolicyInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{TrafficPolicyInstanceStateCreating},
+		Target:  []string{TrafficPolicyInstanceStateApplied},
+		Refresh: statusTrafficPolicyInstanceState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
+		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTrafficPolicyInstanceStateUpdated(conn *route53.Route53, id string, timeout time.Duration) (*route53.TrafficPolicyInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{TrafficPolicyInstanceStateUpdating},
+		Target:  []string{TrafficPolicyInstanceStateApplied},
+		Refresh: statusTrafficPolicyInstanceState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
+		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTrafficPolicyInstanceStateDeleted(conn *route53.Route53, id string, timeout time.Duration) (*route53.TrafficPolicyInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{TrafficPolicyInstanceStateDeleting},
+		Target:  []string{},
+		Refresh: statusTrafficPolicyInstanceState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,329 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketACLCreate,
+		Read:   resourceBucketACLRead,
+		Update: resourceBucketACLUpdate,
+		Delete: resourceBucketACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": s3GranteeSchema(),
+									"permission": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_control_policy"},
+				ValidateFunc:  validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+		},
+	}
+}
+
+func resourceBucketACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input, err := expandS3PutBucketAclInput(d, bucket)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("access_control_policy", flattenS3BucketAccessControlPolicy(output)); err != nil {
+		return fmt.Errorf("error setting access_control_policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input, err := expandS3PutBucketAclInput(d, d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.PutBucketAcl(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLDelete(d *schema.ResourceData, meta interface{}) error {
+	// A bucket always has an ACL, so there is nothing to delete.
+	log.Printf("[WARN] Cannot destroy S3 Bucket ACL. Terraform will remove this resource from the state file, however the bucket ACL will remain.")
+
+	return nil
+}
+
+func expandS3PutBucketAclInput(d *schema.ResourceData, bucket string) (*s3.PutBucketAclInput, error) {
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+
+		return input, nil
+	}
+
+	if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessControlPolicy = expandS3BucketAccessControlPolicy(v.([]interface{})[0].(map[string]interface{}))
+
+		return input, nil
+	}
+
+	return nil, fmt.Errorf("one of acl or access_control_policy must be specified")
+}
+
+func expandS3BucketAccessControlPolicy(tfMap map[string]interface{}) *s3.AccessControlPolicy {
+	apiObject := &s3.AccessControlPolicy{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Grants = append(apiObject.Grants, &s3.Grant{
+				Grantee:    expandS3Grantee(tfMap["grantee"].([]interface{})),
+				Permission: aws.String(tfMap["permission"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Owner = &s3.Owner{
+			ID: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenS3BucketAccessControlPolicy(apiObject *s3.GetBucketAclOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var grants []interface{}
+
+	for _, grant := range apiObject.Grants {
+		if grant == nil {
+			continue
+		}
+
+		grants = append(grants, map[string]interface{}{
+			"grantee":    flattenS3Grantee(grant.Grantee),
+			"permission": aws.StringValue(grant.Permission),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"grant": grants,
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = []interface{}{map[string]interface{}{
+			"display_name": aws.StringValue(v.DisplayName),
+			"id":           aws.StringValue(v.ID),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func s3GranteeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"email_address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+				},
+				"uri": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func expandS3Grantee(tfList []interface{}) *s3.Grantee {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.Grantee{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["email_address"].(string); ok && v != "" {
+		apiObject.EmailAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.URI = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Grantee(apiObject *s3.Grantee) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"display_name":  aws.StringValue(apiObject.DisplayName),
+		"email_address": aws.StringValue(apiObject.EmailAddress),
+		"id":            aws.StringValue(apiObject.ID),
+		"type":          aws.StringValue(apiObject.Type),
+		"uri":           aws.StringValue(apiObject.URI),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,562 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLifecycleConfigurationCreate,
+		Read:   resourceBucketLifecycleConfigurationRead,
+		Update: resourceBucketLifecycleConfigurationUpdate,
+		Delete: resourceBucketLifecycleConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validBucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validBucketLifecycleTransitionStorageClass(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandS3LifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) lifecycle configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketLifecycleConfiguration(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) lifecycle configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) lifecycle configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenS3LifecycleRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	rules, err := expandS3LifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = conn.PutBucketLifecycleConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) lifecycle configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketLifecycle(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) lifecycle configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3LifecycleRules(tfList []interface{}) ([]*s3.LifecycleRule, error) {
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.LifecycleRule{
+			ID:     aws.String(tfMap["id"].(string)),
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(tfMap["days_after_initiation"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			expiration := &s3.LifecycleExpiration{}
+
+			if v, ok := tfMap["date"].(string); ok && v != "" {
+				t, err := parseS3LifecycleDate(v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				expiration.Date = aws.Time(t)
+			}
+
+			if v, ok := tfMap["days"].(int); ok && v > 0 {
+				expiration.Days = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["expired_object_delete_marker"].(bool); ok && v {
+				expiration.ExpiredObjectDeleteMarker = aws.Bool(v)
+			}
+
+			apiObject.Expiration = expiration
+		}
+
+		apiObject.Filter = expandS3LifecycleRuleFilter(tfMap["filter"].([]interface{}))
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(tfMap["noncurrent_days"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.NoncurrentVersionTransitions = append(apiObject.NoncurrentVersionTransitions, &s3.NoncurrentVersionTransition{
+					NoncurrentDays: aws.Int64(int64(tfMap["noncurrent_days"].(int))),
+					StorageClass:   aws.String(tfMap["storage_class"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				transition := &s3.Transition{
+					StorageClass: aws.String(tfMap["storage_class"].(string)),
+				}
+
+				if v, ok := tfMap["date"].(string); ok && v != "" {
+					t, err := parseS3LifecycleDate(v)
+
+					if err != nil {
+						return nil, err
+					}
+
+					transition.Date = aws.Time(t)
+				} else if v, ok := tfMap["days"].(int); ok {
+					transition.Days = aws.Int64(int64(v))
+				}
+
+				apiObject.Transitions = append(apiObject.Transitions, transition)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandS3LifecycleRuleFilter(tfList []interface{}) *s3.LifecycleRuleFilter {
+	// A rule without a filter applies to all objects in the bucket.
+	apiObject := &s3.LifecycleRuleFilter{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		apiObject.Prefix = aws.String("")
+
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		and := &s3.LifecycleRuleAndOperator{}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			and.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			and.Tags = Tags(tftags.New(v).IgnoreAWS())
+		}
+
+		apiObject.And = and
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tag = &s3.Tag{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+
+		return apiObject
+	}
+
+	apiObject.Prefix = aws.String(tfMap["prefix"].(string))
+
+	return apiObject
+}
+
+func flattenS3LifecycleRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":     aws.StringValue(apiObject.ID),
+			"status": aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfMap["abort_incomplete_multipart_upload"] = []interface{}{map[string]interface{}{
+				"days_after_initiation": int(aws.Int64Value(v.DaysAfterInitiation)),
+			}}
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			expiration := map[string]interface{}{
+				"days":                         int(aws.Int64Value(v.Days)),
+				"expired_object_delete_marker": aws.BoolValue(v.ExpiredObjectDeleteMarker),
+			}
+
+			if v.Date != nil {
+				expiration["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			tfMap["expiration"] = []interface{}{expiration}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = flattenS3LifecycleRuleFilter(v)
+		}
+
+		if v := apiObject.NoncurrentVersionExpiration; v != nil {
+			tfMap["noncurrent_version_expiration"] = []interface{}{map[string]interface{}{
+				"noncurrent_days": int(aws.Int64Value(v.NoncurrentDays)),
+			}}
+		}
+
+		var noncurrentVersionTransitions []interface{}
+
+		for _, v := range apiObject.NoncurrentVersionTransitions {
+			noncurrentVersionTransitions = append(noncurrentVersionTransitions, map[string]interface{}{
+				"noncurrent_days": int(aws.Int64Value(v.NoncurrentDays)),
+				"storage_class":   aws.StringValue(v.StorageClass),
+			})
+		}
+
+		tfMap["noncurrent_version_transition"] = noncurrentVersionTransitions
+
+		var transitions []interface{}
+
+		for _, v := range apiObject.Transitions {
+			transition := map[string]interface{}{
+				"days":          int(aws.Int64Value(v.Days)),
+				"storage_class": aws.StringValue(v.StorageClass),
+			}
+
+			if v.Date != nil {
+				transition["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			transitions = append(transitions, transition)
+		}
+
+		tfMap["transition"] = transitions
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3LifecycleRuleFilter(apiObject *s3.LifecycleRuleFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{map[string]interface{}{
+			"prefix": aws.StringValue(v.Prefix),
+			"tags":   KeyValueTags(v.Tags).IgnoreAWS().Map(),
+		}}
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		// An empty prefix is the API representation of a rule with no filter.
+		if aws.StringValue(v) == "" && apiObject.And == nil && apiObject.Tag == nil {
+			return nil
+		}
+
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{map[string]interface{}{
+			"key":   aws.StringValue(v.Key),
+			"value": aws.StringValue(v.Value),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func parseS3LifecycleDate(v string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing S3 Bucket Lifecycle date (%s): %w", v, err)
+	}
+
+	return t, nil
+}
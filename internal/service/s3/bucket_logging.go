@@ -0,0 +1,202 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLoggingCreate,
+		Read:   resourceBucketLoggingRead,
+		Update: resourceBucketLoggingUpdate,
+		Delete: resourceBucketLoggingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_grant": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee": s3GranteeSchema(),
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.BucketLogsPermission_Values(), false),
+						},
+					},
+				},
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceBucketLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandS3BucketLoggingEnabled(d),
+		},
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLogging(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) logging: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLoggingRead(d, meta)
+}
+
+func resourceBucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketLogging(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) logging: %w", d.Id(), err)
+	}
+
+	if output == nil || output.LoggingEnabled == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading S3 Bucket (%s) logging: empty response", d.Id())
+		}
+
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", output.LoggingEnabled.TargetBucket)
+	d.Set("target_prefix", output.LoggingEnabled.TargetPrefix)
+
+	if err := d.Set("target_grant", flattenS3BucketLoggingTargetGrants(output.LoggingEnabled.TargetGrants)); err != nil {
+		return fmt.Errorf("error setting target_grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandS3BucketLoggingEnabled(d),
+		},
+	}
+
+	_, err := conn.PutBucketLogging(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) logging: %w", d.Id(), err)
+	}
+
+	return resourceBucketLoggingRead(d, meta)
+}
+
+func resourceBucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	// An empty logging status disables logging.
+	input := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	}
+
+	_, err := conn.PutBucketLogging(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) logging: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3BucketLoggingEnabled(d *schema.ResourceData) *s3.LoggingEnabled {
+	apiObject := &s3.LoggingEnabled{
+		TargetBucket: aws.String(d.Get("target_bucket").(string)),
+		TargetPrefix: aws.String(d.Get("target_prefix").(string)),
+	}
+
+	for _, tfMapRaw := range d.Get("target_grant").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.TargetGrants = append(apiObject.TargetGrants, &s3.TargetGrant{
+			Grantee:    expandS3Grantee(tfMap["grantee"].([]interface{})),
+			Permission: aws.String(tfMap["permission"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenS3BucketLoggingTargetGrants(apiObjects []*s3.TargetGrant) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"grantee":    flattenS3Grantee(apiObject.Grantee),
+			"permission": aws.StringValue(apiObject.Permission),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,247 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketObjectLockConfigurationCreate,
+		Read:   resourceBucketObjectLockConfigurationRead,
+		Update: resourceBucketObjectLockConfigurationUpdate,
+		Delete: resourceBucketObjectLockConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"object_lock_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.ObjectLockEnabledEnabled,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockEnabled_Values(), false),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ConflictsWith: []string{"rule.0.default_retention.0.years"},
+										ValidateFunc:  validation.IntAtLeast(1),
+									},
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
+									},
+									"years": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ConflictsWith: []string{"rule.0.default_retention.0.days"},
+										ValidateFunc:  validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceBucketObjectLockConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+			Rule:              expandS3ObjectLockRule(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		input.Token = aws.String(v.(string))
+	}
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutObjectLockConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Object Lock configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceBucketObjectLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetObjectLockConfiguration(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeObjectLockConfigurationNotFound) {
+		log.Printf("[WARN] S3 Bucket Object Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ObjectLockConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("object_lock_enabled", output.ObjectLockConfiguration.ObjectLockEnabled)
+
+	if err := d.Set("rule", flattenS3ObjectLockRule(output.ObjectLockConfiguration.Rule)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketObjectLockConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+			Rule:              expandS3ObjectLockRule(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		input.Token = aws.String(v.(string))
+	}
+
+	_, err := conn.PutObjectLockConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Object Lock configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceBucketObjectLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	// Object Lock cannot be disabled once enabled; remove the default retention rule instead.
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		input.Token = aws.String(v.(string))
+	}
+
+	_, err := conn.PutObjectLockConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeObjectLockConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Object Lock configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3ObjectLockRule(tfList []interface{}) *s3.ObjectLockRule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.ObjectLockRule{}
+
+	if v, ok := tfMap["default_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DefaultRetention = &s3.DefaultRetention{
+			Mode: aws.String(tfMap["mode"].(string)),
+		}
+
+		if v, ok := tfMap["days"].(int); ok && v > 0 {
+			apiObject.DefaultRetention.Days = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["years"].(int); ok && v > 0 {
+			apiObject.DefaultRetention.Years = aws.Int64(int64(v))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenS3ObjectLockRule(apiObject *s3.ObjectLockRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DefaultRetention; v != nil {
+		tfMap["default_retention"] = []interface{}{map[string]interface{}{
+			"days":  int(aws.Int64Value(v.Days)),
+			"mode":  aws.StringValue(v.Mode),
+			"years": int(aws.Int64Value(v.Years)),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
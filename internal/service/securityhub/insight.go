@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -359,17 +360,17 @@ func numberFilterSchema() *schema.Schema {
 				"eq": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"gte": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"lte": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 			},
 		},